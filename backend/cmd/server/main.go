@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +19,16 @@ var (
 	dbType   string
 	dbConfig string
 	port     int
+
+	trashRetention time.Duration
+
+	backupInterval time.Duration
+	backupKeep     int
+
+	sqliteForeignKeys bool
+	sqliteJournalMode string
+	sqliteSynchronous string
+	sqliteBusyTimeout time.Duration
 )
 
 var rootCmd = &cobra.Command{
@@ -31,11 +42,27 @@ func init() {
 	rootCmd.Flags().StringVar(&dbType, "db-type", "sqlite", "Database type (sqlite or postgres)")
 	rootCmd.Flags().StringVar(&dbConfig, "db-config", "./planner.db", "Database configuration (path for sqlite, connection string for postgres)")
 	rootCmd.Flags().IntVar(&port, "port", 50051, "gRPC server port")
+	rootCmd.Flags().DurationVar(&trashRetention, "trash-retention", config.DefaultTrashRetention, "How long deleted items stay in the trash before being purged (0 keeps them forever)")
+	rootCmd.Flags().DurationVar(&backupInterval, "backup-interval", config.DefaultBackupInterval, "How often SQLite databases are backed up (0 only backs up on request)")
+	rootCmd.Flags().IntVar(&backupKeep, "backup-keep", config.DefaultBackupKeep, "How many of the most recent backups to keep (0 keeps them all)")
+	rootCmd.Flags().BoolVar(&sqliteForeignKeys, "sqlite-foreign-keys", true, "Enforce foreign key constraints in SQLite databases")
+	rootCmd.Flags().StringVar(&sqliteJournalMode, "sqlite-journal-mode", config.DefaultSQLiteJournalMode, "SQLite journal mode (DELETE, TRUNCATE, PERSIST, MEMORY, WAL or OFF)")
+	rootCmd.Flags().StringVar(&sqliteSynchronous, "sqlite-synchronous", config.DefaultSQLiteSynchronous, "SQLite synchronous setting (OFF, NORMAL, FULL or EXTRA)")
+	rootCmd.Flags().DurationVar(&sqliteBusyTimeout, "sqlite-busy-timeout", config.DefaultSQLiteBusyTimeout, "How long SQLite waits for a locked database before giving up")
 }
 
 func runServer(cmd *cobra.Command, args []string) {
 	// Create configuration
 	cfg := config.ServerStandaloneConfig(dbType, dbConfig, port)
+	cfg.Trash.Retention = trashRetention
+	cfg.Backup.Interval = backupInterval
+	cfg.Backup.Keep = backupKeep
+	cfg.Database.SQLite = config.SQLiteConfig{
+		ForeignKeys: sqliteForeignKeys,
+		JournalMode: sqliteJournalMode,
+		Synchronous: sqliteSynchronous,
+		BusyTimeout: sqliteBusyTimeout,
+	}
 
 	// Initialize database
 	var store *db.Store
@@ -44,7 +71,12 @@ func runServer(cmd *cobra.Command, args []string) {
 	log.Printf("Initializing database (type: %s)...\n", cfg.Database.Type)
 	switch cfg.Database.Type {
 	case "sqlite":
-		store, err = db.OpenSQLite(cfg.Database.Path)
+		store, err = db.OpenSQLite(cfg.Database.Path,
+			db.WithForeignKeys(cfg.Database.SQLite.ForeignKeys),
+			db.WithJournalMode(cfg.Database.SQLite.JournalMode),
+			db.WithSynchronous(cfg.Database.SQLite.Synchronous),
+			db.WithBusyTimeout(cfg.Database.SQLite.BusyTimeout),
+		)
 		if err != nil {
 			log.Fatalf("Failed to open SQLite database: %v", err)
 		}
@@ -63,7 +95,10 @@ func runServer(cmd *cobra.Command, args []string) {
 	defer store.Close()
 
 	// Create and start gRPC server
-	srv := server.New(store)
+	srv := server.New(store,
+		server.WithTrashRetention(cfg.Trash.Retention),
+		server.WithBackups(cfg.Backup.Interval, cfg.Backup.Keep),
+	)
 	serverAddr := cfg.ServerAddress()
 
 	log.Printf("Starting gRPC server on %s...\n", serverAddr)
@@ -4,6 +4,23 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
+)
+
+// DefaultTrashRetention is how long deleted items stay in the trash by default
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// Default schedule for SQLite backups: daily, keeping a week of them
+const (
+	DefaultBackupInterval = 24 * time.Hour
+	DefaultBackupKeep     = 7
+)
+
+// Default SQLite connection settings
+const (
+	DefaultSQLiteJournalMode = "WAL"
+	DefaultSQLiteSynchronous = "NORMAL"
+	DefaultSQLiteBusyTimeout = 5 * time.Second
 )
 
 // Config holds application configuration
@@ -16,6 +33,12 @@ type Config struct {
 
 	// Server configuration
 	Server ServerConfig
+
+	// Trash configuration
+	Trash TrashConfig
+
+	// Backup configuration
+	Backup BackupConfig
 }
 
 // Mode represents the application mode
@@ -39,6 +62,24 @@ type DatabaseConfig struct {
 
 	// ConnectionString is used for PostgreSQL connections
 	ConnectionString string
+
+	// SQLite holds the connection settings for SQLite databases
+	SQLite SQLiteConfig
+}
+
+// SQLiteConfig holds the settings applied to every SQLite connection
+type SQLiteConfig struct {
+	// ForeignKeys enables enforcement of foreign key constraints
+	ForeignKeys bool
+
+	// JournalMode is the journal mode (DELETE, TRUNCATE, PERSIST, MEMORY, WAL or OFF)
+	JournalMode string
+
+	// Synchronous is how often writes are flushed to disk (OFF, NORMAL, FULL or EXTRA)
+	Synchronous string
+
+	// BusyTimeout is how long to wait for a locked database before giving up
+	BusyTimeout time.Duration
 }
 
 // ServerConfig holds gRPC server configuration
@@ -50,6 +91,21 @@ type ServerConfig struct {
 	Port int
 }
 
+// TrashConfig holds configuration for deleted items
+type TrashConfig struct {
+	// Retention is how long deleted items are kept before being purged (0 keeps them forever)
+	Retention time.Duration
+}
+
+// BackupConfig holds configuration for backups of SQLite databases
+type BackupConfig struct {
+	// Interval is how often the database is backed up (0 only backs up on request)
+	Interval time.Duration
+
+	// Keep is how many of the most recent backups are kept (0 keeps them all)
+	Keep int
+}
+
 // DefaultConfig returns a default configuration for in-process mode
 func DefaultConfig() (*Config, error) {
 	dataDir, err := userDataDir()
@@ -67,13 +123,21 @@ func DefaultConfig() (*Config, error) {
 	return &Config{
 		Mode: ModeInProcess,
 		Database: DatabaseConfig{
-			Type: "sqlite",
-			Path: dbPath,
+			Type:   "sqlite",
+			Path:   dbPath,
+			SQLite: defaultSQLiteConfig(),
 		},
 		Server: ServerConfig{
 			Address: "localhost",
 			Port:    50051,
 		},
+		Trash: TrashConfig{
+			Retention: DefaultTrashRetention,
+		},
+		Backup: BackupConfig{
+			Interval: DefaultBackupInterval,
+			Keep:     DefaultBackupKeep,
+		},
 	}, nil
 }
 
@@ -93,12 +157,20 @@ func ServerStandaloneConfig(dbType, dbConfig string, port int) *Config {
 	cfg := &Config{
 		Mode: ModeStandalone,
 		Database: DatabaseConfig{
-			Type: dbType,
+			Type:   dbType,
+			SQLite: defaultSQLiteConfig(),
 		},
 		Server: ServerConfig{
 			Address: "0.0.0.0",
 			Port:    port,
 		},
+		Trash: TrashConfig{
+			Retention: DefaultTrashRetention,
+		},
+		Backup: BackupConfig{
+			Interval: DefaultBackupInterval,
+			Keep:     DefaultBackupKeep,
+		},
 	}
 
 	if dbType == "sqlite" {
@@ -110,6 +182,16 @@ func ServerStandaloneConfig(dbType, dbConfig string, port int) *Config {
 	return cfg
 }
 
+// defaultSQLiteConfig returns the default SQLite connection settings
+func defaultSQLiteConfig() SQLiteConfig {
+	return SQLiteConfig{
+		ForeignKeys: true,
+		JournalMode: DefaultSQLiteJournalMode,
+		Synchronous: DefaultSQLiteSynchronous,
+		BusyTimeout: DefaultSQLiteBusyTimeout,
+	}
+}
+
 // ServerAddress returns the full server address (host:port)
 func (c *Config) ServerAddress() string {
 	return fmt.Sprintf("%s:%d", c.Server.Address, c.Server.Port)
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// A change recorded by a transaction that commits later than one that started
+// after it must still reach a watcher that polls in between
+func TestPostgresChangesCommitInIDOrder(t *testing.T) {
+	store := openTestPostgres(t)
+	ctx := context.Background()
+
+	bounds, err := store.Queries.GetChangeBounds(ctx)
+	if err != nil {
+		t.Fatalf("failed to get change bounds: %v", err)
+	}
+
+	createArea := func(q Querier) (string, error) {
+		now := time.Now()
+		area, err := q.CreateArea(ctx, CreateAreaParams{
+			ID:        uuid.New().String(),
+			Name:      "change order",
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		return area.ID, err
+	}
+
+	// The first transaction records its change and stays open
+	first, err := store.db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("failed to begin first transaction: %v", err)
+	}
+	defer first.Rollback()
+	firstID, err := createArea(store.newQueries(first))
+	if err != nil {
+		t.Fatalf("failed to create first area: %v", err)
+	}
+
+	// The second records one after it and commits as soon as it can
+	type result struct {
+		id  string
+		err error
+	}
+	secondDone := make(chan result, 1)
+	go func() {
+		second, err := store.db.BeginTx(ctx, nil)
+		if err != nil {
+			secondDone <- result{err: err}
+			return
+		}
+		defer second.Rollback()
+		id, err := createArea(store.newQueries(second))
+		if err == nil {
+			err = second.Commit()
+		}
+		secondDone <- result{id: id, err: err}
+	}()
+
+	// A watcher reads the log while only the second can have committed, then
+	// again once both have
+	cursor := bounds.LatestID
+	seen := map[string]bool{}
+	poll := func() {
+		changes, err := store.Queries.ListChanges(ctx, ListChangesParams{AfterID: cursor, Limit: 100})
+		if err != nil {
+			t.Fatalf("failed to list changes: %v", err)
+		}
+		for _, change := range changes {
+			seen[change.EntityID] = true
+			cursor = change.ID
+		}
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	poll()
+	if err := first.Commit(); err != nil {
+		t.Fatalf("failed to commit first transaction: %v", err)
+	}
+	second := <-secondDone
+	if second.err != nil {
+		t.Fatalf("second transaction failed: %v", second.err)
+	}
+	poll()
+
+	for name, id := range map[string]string{"first": firstID, "second": second.id} {
+		if !seen[id] {
+			t.Errorf("watcher never saw the %s transaction's change", name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxRepairPasses bounds repairForeignKeys. Deleting an orphan can orphan the
+// rows that depend on it, so a repair takes one pass per level of nesting.
+const maxRepairPasses = 10
+
+// recoveredAreaName names the area that projects whose area no longer exists
+// are moved to, since a project cannot be without one
+const recoveredAreaName = "Recovered projects"
+
+// foreignKeyViolation is a row that PRAGMA foreign_key_check reports as
+// referring to a row that does not exist
+type foreignKeyViolation struct {
+	table  string
+	rowID  int64
+	parent string
+	fkID   int
+}
+
+// foreignKey describes one of a table's foreign keys
+type foreignKey struct {
+	column   string
+	onDelete string
+	notNull  bool
+}
+
+// repairForeignKeys fixes the rows of a SQLite database that refer to rows
+// that do not exist, which a database written with foreign keys off can
+// contain, so that foreign keys can be enforced. Each orphan is dealt with as
+// enforcement would have, had it been on when its parent was deleted: rows
+// that are deleted along with their parent are deleted, and optional
+// references are cleared, so tasks of a missing project go to the Inbox.
+// Projects of a missing area are moved to a new area rather than lost. It
+// returns a description of each repair.
+func repairForeignKeys(ctx context.Context, db *sql.DB) ([]string, error) {
+	var repairs []string
+	recoveredArea := ""
+
+	for pass := 0; pass < maxRepairPasses; pass++ {
+		violations, err := foreignKeyCheck(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		if len(violations) == 0 {
+			return repairs, nil
+		}
+
+		keys := map[string]map[int]foreignKey{}
+		for _, v := range violations {
+			if keys[v.table] == nil {
+				if keys[v.table], err = foreignKeys(ctx, db, v.table); err != nil {
+					return nil, err
+				}
+			}
+			key, ok := keys[v.table][v.fkID]
+			if !ok {
+				return nil, fmt.Errorf("unknown foreign key %d of %s", v.fkID, v.table)
+			}
+
+			switch {
+			case key.onDelete == "CASCADE":
+				_, err = db.ExecContext(ctx, fmt.Sprintf("DELETE FROM %q WHERE rowid = ?", v.table), v.rowID)
+				repairs = append(repairs, fmt.Sprintf("deleted %s row %d, whose row in %s no longer exists", v.table, v.rowID, v.parent))
+			case !key.notNull:
+				_, err = db.ExecContext(ctx, fmt.Sprintf("UPDATE %q SET %q = NULL WHERE rowid = ?", v.table, key.column), v.rowID)
+				repairs = append(repairs, fmt.Sprintf("cleared %s of %s row %d, whose row in %s no longer exists", key.column, v.table, v.rowID, v.parent))
+			case v.table == "projects" && key.column == "area_id":
+				if recoveredArea == "" {
+					if recoveredArea, err = createRecoveredArea(ctx, db); err != nil {
+						return nil, err
+					}
+				}
+				_, err = db.ExecContext(ctx, "UPDATE projects SET area_id = ? WHERE rowid = ?", recoveredArea, v.rowID)
+				repairs = append(repairs, fmt.Sprintf("moved projects row %d, whose area no longer exists, to %q", v.rowID, recoveredAreaName))
+			default:
+				return nil, fmt.Errorf("cannot repair %s row %d, whose row in %s no longer exists", v.table, v.rowID, v.parent)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to repair %s row %d: %w", v.table, v.rowID, err)
+			}
+		}
+	}
+	return nil, fmt.Errorf("foreign keys still broken after %d repair passes", maxRepairPasses)
+}
+
+// foreignKeyCheck lists the rows that refer to rows that do not exist
+func foreignKeyCheck(ctx context.Context, db *sql.DB) ([]foreignKeyViolation, error) {
+	rows, err := db.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []foreignKeyViolation
+	for rows.Next() {
+		var v foreignKeyViolation
+		if err := rows.Scan(&v.table, &v.rowID, &v.parent, &v.fkID); err != nil {
+			return nil, fmt.Errorf("failed to check foreign keys: %w", err)
+		}
+		violations = append(violations, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	return violations, nil
+}
+
+// foreignKeys returns the single-column foreign keys of a table by id
+func foreignKeys(ctx context.Context, db *sql.DB, table string) (map[int]foreignKey, error) {
+	notNull := map[string]bool{}
+	columns, err := db.QueryContext(ctx, "SELECT name, \"notnull\" FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list columns of %s: %w", table, err)
+	}
+	defer columns.Close()
+	for columns.Next() {
+		var name string
+		var required bool
+		if err := columns.Scan(&name, &required); err != nil {
+			return nil, fmt.Errorf("failed to list columns of %s: %w", table, err)
+		}
+		notNull[name] = required
+	}
+	if err := columns.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list columns of %s: %w", table, err)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT id, \"from\", on_delete FROM pragma_foreign_key_list(?)", table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	keys := map[int]foreignKey{}
+	for rows.Next() {
+		var id int
+		var key foreignKey
+		if err := rows.Scan(&id, &key.column, &key.onDelete); err != nil {
+			return nil, fmt.Errorf("failed to list foreign keys of %s: %w", table, err)
+		}
+		key.notNull = notNull[key.column]
+		keys[id] = key
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys of %s: %w", table, err)
+	}
+	return keys, nil
+}
+
+// createRecoveredArea creates the area that orphaned projects are moved to
+func createRecoveredArea(ctx context.Context, db *sql.DB) (string, error) {
+	now := time.Now()
+	area, err := New(db).CreateArea(ctx, CreateAreaParams{
+		ID:          uuid.New().String(),
+		Name:        recoveredAreaName,
+		Description: sql.NullString{String: "Projects whose area was deleted without them", Valid: true},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create area for orphaned projects: %w", err)
+	}
+	return area.ID, nil
+}
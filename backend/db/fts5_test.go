@@ -0,0 +1,18 @@
+//go:build !sqlite_fts5
+
+package db
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+// Without the sqlite_fts5 tag the module still builds, and OpenSQLite says why
+// it cannot open a database rather than failing partway through migrating it
+func TestOpenSQLiteWithoutFTS5(t *testing.T) {
+	_, err := OpenSQLite(filepath.Join(t.TempDir(), "planner.db"))
+	if !errors.Is(err, ErrFTS5Unavailable) {
+		t.Errorf("OpenSQLite without FTS5 = %v, want ErrFTS5Unavailable", err)
+	}
+}
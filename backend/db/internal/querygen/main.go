@@ -0,0 +1,351 @@
+// Command querygen writes querier.go in the db package: the Querier interface
+// that the server codes against, and an adapter that puts the queries sqlc
+// generates for PostgreSQL behind it.
+//
+// The queries sqlc generates for SQLite are the reference. Every method of
+// db.Queries that takes a context becomes part of Querier, and db/postgres
+// must have a method of the same name whose parameters and results convert
+// to the same Go types. A query missing from either dialect is reported here;
+// one whose types differ fails to compile in the adapter.
+//
+// Run it from backend/db after sqlc generate:
+//
+//	go run ./internal/querygen
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	output       = "querier.go"
+	postgresDir  = "postgres"
+	postgresPath = "github.com/liamawhite/planner/backend/db/postgres"
+)
+
+// method is a query method of a generated Queries type
+type method struct {
+	name string
+	doc  *ast.CommentGroup
+	typ  *ast.FuncType
+	file *ast.File
+}
+
+// pkg is what querygen needs to know about a package of queries
+type pkg struct {
+	fset    *token.FileSet
+	methods map[string]method
+	types   map[string]bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("querygen: ")
+
+	sqlite, err := load(".", output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	postgres, err := load(postgresDir, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := checkMissing(sqlite, postgres); err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := generate(sqlite, postgres)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load parses the package in dir, skipping the file named skip
+func load(dir, skip string) (*pkg, error) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &pkg{
+		fset:    fset,
+		methods: map[string]method{},
+		types:   map[string]bool{},
+	}
+	for _, path := range paths {
+		if filepath.Base(path) == skip || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						p.types[spec.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if isQuery(decl) {
+					p.methods[decl.Name.Name] = method{
+						name: decl.Name.Name,
+						doc:  decl.Doc,
+						typ:  decl.Type,
+						file: file,
+					}
+				}
+			}
+		}
+	}
+	if len(p.methods) == 0 {
+		return nil, fmt.Errorf("no queries found in %s; run sqlc generate first", dir)
+	}
+	return p, nil
+}
+
+// isQuery reports whether decl is an exported method of *Queries whose first
+// parameter is a context
+func isQuery(decl *ast.FuncDecl) bool {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 || !decl.Name.IsExported() {
+		return false
+	}
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	if recv, ok := star.X.(*ast.Ident); !ok || recv.Name != "Queries" {
+		return false
+	}
+	params := decl.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	sel, ok := params[0].Type.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "Context"
+}
+
+// checkMissing reports the queries that one dialect has and the other lacks
+func checkMissing(sqlite, postgres *pkg) error {
+	var problems []string
+	for name := range sqlite.methods {
+		if _, ok := postgres.methods[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s has no PostgreSQL query", name))
+		}
+	}
+	for name := range postgres.methods {
+		if _, ok := sqlite.methods[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s has no SQLite query", name))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("dialects disagree:\n\t%s", strings.Join(problems, "\n\t"))
+}
+
+// generate renders querier.go
+func generate(sqlite, postgres *pkg) ([]byte, error) {
+	names := make([]string, 0, len(sqlite.methods))
+	for name := range sqlite.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	imports := map[string]bool{postgresPath: true}
+	var iface, adapter bytes.Buffer
+	for _, name := range names {
+		m := sqlite.methods[name]
+		if err := addImports(imports, m); err != nil {
+			return nil, err
+		}
+
+		if m.doc != nil {
+			for _, line := range strings.Split(strings.TrimSpace(m.doc.Text()), "\n") {
+				fmt.Fprintf(&iface, "\t// %s\n", line)
+			}
+		}
+		fmt.Fprintf(&iface, "\t%s%s\n", name, strings.TrimPrefix(sqlite.expr(m.typ), "func"))
+
+		if err := writeAdapter(&adapter, sqlite, postgres, m, postgres.methods[name]); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by querygen. DO NOT EDIT.\n\npackage db\n\nimport (\n")
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	out.WriteString(")\n\n")
+	out.WriteString("// Querier is the set of queries every database dialect provides\n")
+	fmt.Fprintf(&out, "type Querier interface {\n%s}\n\n", iface.String())
+	out.WriteString("var _ Querier = (*Queries)(nil)\n\n")
+	out.WriteString("// postgresQueries puts the queries generated for PostgreSQL behind Querier\n")
+	out.WriteString("type postgresQueries struct {\n\tq *postgres.Queries\n}\n\n")
+	out.WriteString("func newPostgresQueries(db DBTX) Querier {\n\treturn &postgresQueries{q: postgres.New(db)}\n}\n")
+	out.Write(adapter.Bytes())
+
+	return format.Source(out.Bytes())
+}
+
+// addImports records the packages that m's signature refers to
+func addImports(imports map[string]bool, m method) error {
+	var err error
+	ast.Inspect(m.typ, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		pkgName, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		path, found := importPath(m.file, pkgName.Name)
+		if !found {
+			err = fmt.Errorf("%s: cannot find import for %s", m.name, pkgName.Name)
+			return false
+		}
+		imports[path] = true
+		return false
+	})
+	return err
+}
+
+// importPath finds the import of file that is referred to as name
+func importPath(file *ast.File, name string) (string, bool) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		if spec.Name != nil {
+			if spec.Name.Name == name {
+				return path, true
+			}
+			continue
+		}
+		if path == name || strings.HasSuffix(path, "/"+name) {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// writeAdapter writes the postgresQueries method for one query, converting
+// its arguments to the PostgreSQL package's types and its results back
+func writeAdapter(w *bytes.Buffer, sqlite, postgres *pkg, m, pg method) error {
+	params := m.typ.Params.List
+	pgParams := flatten(pg.typ.Params.List)
+	if len(flatten(params)) != len(pgParams) {
+		return fmt.Errorf("takes %d parameters on SQLite but %d on PostgreSQL", len(flatten(params)), len(pgParams))
+	}
+
+	var sig, args []string
+	i := 0
+	for _, field := range params {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, n := range names {
+			sig = append(sig, n.Name+" "+sqlite.expr(field.Type))
+			arg := n.Name
+			if ident, ok := pgParams[i].(*ast.Ident); ok && postgres.types[ident.Name] {
+				arg = fmt.Sprintf("postgres.%s(%s)", ident.Name, n.Name)
+			}
+			args = append(args, arg)
+			i++
+		}
+	}
+
+	var results []ast.Expr
+	if m.typ.Results != nil {
+		results = flatten(m.typ.Results.List)
+	}
+	call := fmt.Sprintf("p.q.%s(%s)", m.name, strings.Join(args, ", "))
+
+	resultTypes := make([]string, len(results))
+	for i, result := range results {
+		resultTypes[i] = sqlite.expr(result)
+	}
+	resultList := strings.Join(resultTypes, ", ")
+	if len(results) > 1 {
+		resultList = "(" + resultList + ")"
+	}
+
+	fmt.Fprintf(w, "\nfunc (p *postgresQueries) %s(%s) %s {\n", m.name, strings.Join(sig, ", "), resultList)
+	defer w.WriteString("}\n")
+
+	if len(results) != 2 {
+		fmt.Fprintf(w, "\treturn %s\n", call)
+		return nil
+	}
+	switch t := results[0].(type) {
+	case *ast.Ident:
+		if sqlite.types[t.Name] {
+			fmt.Fprintf(w, "\tr, err := %s\n\treturn %s(r), err\n", call, t.Name)
+			return nil
+		}
+	case *ast.ArrayType:
+		if elem, ok := t.Elt.(*ast.Ident); ok && sqlite.types[elem.Name] {
+			fmt.Fprintf(w, "\trows, err := %s\n", call)
+			w.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+			fmt.Fprintf(w, "\titems := make([]%s, len(rows))\n", elem.Name)
+			fmt.Fprintf(w, "\tfor i, r := range rows {\n\t\titems[i] = %s(r)\n\t}\n", elem.Name)
+			w.WriteString("\treturn items, nil\n")
+			return nil
+		}
+	}
+	fmt.Fprintf(w, "\treturn %s\n", call)
+	return nil
+}
+
+// flatten lists the type of each parameter or result, repeating the type of
+// fields that declare several names
+func flatten(fields []*ast.Field) []ast.Expr {
+	var types []ast.Expr
+	for _, field := range fields {
+		n := max(len(field.Names), 1)
+		for range n {
+			types = append(types, field.Type)
+		}
+	}
+	return types
+}
+
+// expr prints a node of the package's syntax tree
+func (p *pkg) expr(node ast.Node) string {
+	if node == nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, p.fset, node); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'completed', 'cancelled'));
+ALTER TABLE tasks ADD COLUMN completed_at TIMESTAMPTZ;
+
+CREATE INDEX idx_tasks_status ON tasks(status);
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_completed_at;
+DROP INDEX IF EXISTS idx_tasks_status;
+
+ALTER TABLE tasks DROP COLUMN completed_at;
+ALTER TABLE tasks DROP COLUMN status;
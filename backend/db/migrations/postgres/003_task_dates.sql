@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN due_date TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN start_date TIMESTAMPTZ;
+
+CREATE INDEX idx_tasks_due_date ON tasks(due_date);
+CREATE INDEX idx_tasks_start_date ON tasks(start_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_start_date;
+DROP INDEX IF EXISTS idx_tasks_due_date;
+
+ALTER TABLE tasks DROP COLUMN start_date;
+ALTER TABLE tasks DROP COLUMN due_date;
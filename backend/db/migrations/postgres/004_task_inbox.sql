@@ -0,0 +1,8 @@
+-- +goose Up
+-- Tasks without a project live in the Inbox
+ALTER TABLE tasks ALTER COLUMN project_id DROP NOT NULL;
+
+-- +goose Down
+DELETE FROM tasks WHERE project_id IS NULL;
+
+ALTER TABLE tasks ALTER COLUMN project_id SET NOT NULL;
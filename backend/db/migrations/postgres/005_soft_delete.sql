@@ -0,0 +1,21 @@
+-- +goose Up
+ALTER TABLE areas ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE projects ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE tasks ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_areas_deleted_at ON areas(deleted_at);
+CREATE INDEX idx_projects_deleted_at ON projects(deleted_at);
+CREATE INDEX idx_tasks_deleted_at ON tasks(deleted_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_deleted_at;
+DROP INDEX IF EXISTS idx_projects_deleted_at;
+DROP INDEX IF EXISTS idx_areas_deleted_at;
+
+DELETE FROM tasks WHERE deleted_at IS NOT NULL;
+DELETE FROM projects WHERE deleted_at IS NOT NULL;
+DELETE FROM areas WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE tasks DROP COLUMN deleted_at;
+ALTER TABLE projects DROP COLUMN deleted_at;
+ALTER TABLE areas DROP COLUMN deleted_at;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE checklist_items (
+    id TEXT PRIMARY KEY,
+    task_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    completed BOOLEAN NOT NULL DEFAULT FALSE,
+    position BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_checklist_items_task_id ON checklist_items(task_id, position);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_checklist_items_task_id;
+DROP TABLE IF EXISTS checklist_items;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- sort_order is a fractional index: an item is moved by giving it a key between
+-- its new neighbours, so reordering never renumbers the rest of the list.
+-- Existing rows start out in the previous default order, newest first.
+ALTER TABLE areas ADD COLUMN sort_order DOUBLE PRECISION NOT NULL DEFAULT 0;
+UPDATE areas SET sort_order = (
+    SELECT COUNT(*) FROM areas a
+    WHERE a.created_at > areas.created_at
+       OR (a.created_at = areas.created_at AND a.id > areas.id)
+);
+CREATE INDEX idx_areas_sort_order ON areas(sort_order);
+
+ALTER TABLE projects ADD COLUMN sort_order DOUBLE PRECISION NOT NULL DEFAULT 0;
+UPDATE projects SET sort_order = (
+    SELECT COUNT(*) FROM projects p
+    WHERE p.created_at > projects.created_at
+       OR (p.created_at = projects.created_at AND p.id > projects.id)
+);
+CREATE INDEX idx_projects_sort_order ON projects(sort_order);
+
+ALTER TABLE tasks ADD COLUMN sort_order DOUBLE PRECISION NOT NULL DEFAULT 0;
+UPDATE tasks SET sort_order = (
+    SELECT COUNT(*) FROM tasks t
+    WHERE t.created_at > tasks.created_at
+       OR (t.created_at = tasks.created_at AND t.id > tasks.id)
+);
+CREATE INDEX idx_tasks_sort_order ON tasks(sort_order);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_sort_order;
+ALTER TABLE tasks DROP COLUMN sort_order;
+
+DROP INDEX IF EXISTS idx_projects_sort_order;
+ALTER TABLE projects DROP COLUMN sort_order;
+
+DROP INDEX IF EXISTS idx_areas_sort_order;
+ALTER TABLE areas DROP COLUMN sort_order;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE projects ADD COLUMN status TEXT NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'someday', 'completed', 'archived'));
+ALTER TABLE projects ADD COLUMN completed_at TIMESTAMPTZ;
+ALTER TABLE projects ADD COLUMN archived_at TIMESTAMPTZ;
+
+CREATE INDEX idx_projects_status ON projects(status);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_projects_status;
+
+ALTER TABLE projects DROP COLUMN archived_at;
+ALTER TABLE projects DROP COLUMN completed_at;
+ALTER TABLE projects DROP COLUMN status;
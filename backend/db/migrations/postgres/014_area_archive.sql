@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE areas ADD COLUMN archived_at TIMESTAMPTZ;
+
+CREATE INDEX idx_areas_archived_at ON areas(archived_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_areas_archived_at;
+
+ALTER TABLE areas DROP COLUMN archived_at;
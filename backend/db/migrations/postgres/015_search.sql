@@ -0,0 +1,21 @@
+-- +goose Up
+-- search_vector is the document an item is searched by. Names weigh more than
+-- notes when ranking matches. It is indexed as an expression rather than
+-- stored in a column so that queries selecting whole rows are unchanged.
+-- +goose StatementBegin
+CREATE FUNCTION search_vector(name TEXT, notes TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('english', name), 'A') ||
+           setweight(to_tsvector('english', COALESCE(notes, '')), 'B')
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+CREATE INDEX idx_areas_search_vector ON areas USING GIN (search_vector(name, description));
+CREATE INDEX idx_projects_search_vector ON projects USING GIN (search_vector(name, notes));
+CREATE INDEX idx_tasks_search_vector ON tasks USING GIN (search_vector(name, notes));
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+DROP INDEX IF EXISTS idx_projects_search_vector;
+DROP INDEX IF EXISTS idx_areas_search_vector;
+
+DROP FUNCTION IF EXISTS search_vector(TEXT, TEXT);
@@ -0,0 +1,123 @@
+-- +goose Up
+-- changes is an append-only log of every create, update and delete of an
+-- area, project or task, in commit order (see log_change). Its id is the
+-- cursor that watchers resume from.
+CREATE TABLE changes (
+    id BIGSERIAL PRIMARY KEY,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('area', 'project', 'task')),
+    entity_id TEXT NOT NULL,
+    operation TEXT NOT NULL CHECK (operation IN ('create', 'update', 'delete')),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- log_change appends a change to the log. An id is drawn from the sequence
+-- when the row is inserted, not when its transaction commits, so two
+-- transactions could otherwise commit their changes out of id order and a
+-- watcher that had already read past the higher id would never see the lower
+-- one. The advisory lock, held until the transaction ends, makes transactions
+-- that record changes take ids one at a time, in the order they commit.
+-- +goose StatementBegin
+CREATE FUNCTION log_change(change_entity_type TEXT, change_entity_id TEXT, change_operation TEXT) RETURNS void AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('changes'));
+    INSERT INTO changes (entity_type, entity_id, operation)
+    VALUES (change_entity_type, change_entity_id, change_operation);
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- record_change logs a change to the row of the table it fires on, whose
+-- entity type is the trigger's argument. Moving an item to the trash reads as
+-- a delete and restoring it as a create; edits to items that stay in the
+-- trash are not recorded.
+-- +goose StatementBegin
+CREATE FUNCTION record_change() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM log_change(TG_ARGV[0], NEW.id, 'create');
+    ELSIF TG_OP = 'DELETE' THEN
+        IF OLD.deleted_at IS NULL THEN
+            PERFORM log_change(TG_ARGV[0], OLD.id, 'delete');
+        END IF;
+    ELSIF OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL THEN
+        PERFORM log_change(TG_ARGV[0], NEW.id, 'delete');
+    ELSIF OLD.deleted_at IS NOT NULL AND NEW.deleted_at IS NULL THEN
+        PERFORM log_change(TG_ARGV[0], NEW.id, 'create');
+    ELSIF NEW.deleted_at IS NULL THEN
+        PERFORM log_change(TG_ARGV[0], NEW.id, 'update');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- Checklists, tags and dependencies are part of the task they belong to, so
+-- changing them updates the task
+-- +goose StatementBegin
+CREATE FUNCTION record_task_change() RETURNS trigger AS $$
+DECLARE
+    owner_id TEXT := CASE WHEN TG_OP = 'DELETE' THEN OLD.task_id ELSE NEW.task_id END;
+BEGIN
+    IF EXISTS (SELECT 1 FROM tasks WHERE id = owner_id AND deleted_at IS NULL) THEN
+        PERFORM log_change('task', owner_id, 'update');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- Tags and headings are part of the project they belong to, so changing them
+-- updates the project
+-- +goose StatementBegin
+CREATE FUNCTION record_project_change() RETURNS trigger AS $$
+DECLARE
+    owner_id TEXT := CASE WHEN TG_OP = 'DELETE' THEN OLD.project_id ELSE NEW.project_id END;
+BEGIN
+    IF EXISTS (SELECT 1 FROM projects WHERE id = owner_id AND deleted_at IS NULL) THEN
+        PERFORM log_change('project', owner_id, 'update');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER areas_changes AFTER INSERT OR UPDATE OR DELETE ON areas
+    FOR EACH ROW EXECUTE FUNCTION record_change('area');
+
+CREATE TRIGGER projects_changes AFTER INSERT OR UPDATE OR DELETE ON projects
+    FOR EACH ROW EXECUTE FUNCTION record_change('project');
+
+CREATE TRIGGER tasks_changes AFTER INSERT OR UPDATE OR DELETE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION record_change('task');
+
+CREATE TRIGGER checklist_items_changes AFTER INSERT OR UPDATE OR DELETE ON checklist_items
+    FOR EACH ROW EXECUTE FUNCTION record_task_change();
+
+CREATE TRIGGER task_tags_changes AFTER INSERT OR DELETE ON task_tags
+    FOR EACH ROW EXECUTE FUNCTION record_task_change();
+
+CREATE TRIGGER task_dependencies_changes AFTER INSERT OR DELETE ON task_dependencies
+    FOR EACH ROW EXECUTE FUNCTION record_task_change();
+
+CREATE TRIGGER project_tags_changes AFTER INSERT OR DELETE ON project_tags
+    FOR EACH ROW EXECUTE FUNCTION record_project_change();
+
+CREATE TRIGGER headings_changes AFTER INSERT OR UPDATE OR DELETE ON headings
+    FOR EACH ROW EXECUTE FUNCTION record_project_change();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS headings_changes ON headings;
+DROP TRIGGER IF EXISTS project_tags_changes ON project_tags;
+DROP TRIGGER IF EXISTS task_dependencies_changes ON task_dependencies;
+DROP TRIGGER IF EXISTS task_tags_changes ON task_tags;
+DROP TRIGGER IF EXISTS checklist_items_changes ON checklist_items;
+DROP TRIGGER IF EXISTS tasks_changes ON tasks;
+DROP TRIGGER IF EXISTS projects_changes ON projects;
+DROP TRIGGER IF EXISTS areas_changes ON areas;
+
+DROP FUNCTION IF EXISTS record_project_change();
+DROP FUNCTION IF EXISTS record_task_change();
+DROP FUNCTION IF EXISTS record_change();
+DROP FUNCTION IF EXISTS log_change(TEXT, TEXT, TEXT);
+
+DROP TABLE IF EXISTS changes;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- version counts the updates made to a row, so that a client can make an
+-- update or delete conditional on the row being as it last saw it
+ALTER TABLE areas ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE projects ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE tasks ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE tasks DROP COLUMN version;
+ALTER TABLE projects DROP COLUMN version;
+ALTER TABLE areas DROP COLUMN version;
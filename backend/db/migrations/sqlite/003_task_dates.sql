@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE tasks ADD COLUMN due_date TIMESTAMP;
+ALTER TABLE tasks ADD COLUMN start_date TIMESTAMP;
+
+CREATE INDEX idx_tasks_due_date ON tasks(due_date);
+CREATE INDEX idx_tasks_start_date ON tasks(start_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_start_date;
+DROP INDEX IF EXISTS idx_tasks_due_date;
+
+ALTER TABLE tasks DROP COLUMN start_date;
+ALTER TABLE tasks DROP COLUMN due_date;
@@ -0,0 +1,61 @@
+-- +goose Up
+-- Tasks without a project live in the Inbox. SQLite cannot drop a NOT NULL
+-- constraint in place, so the table is rebuilt with a nullable project_id.
+CREATE TABLE tasks_new (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    project_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'completed', 'cancelled')),
+    completed_at TIMESTAMP,
+    due_date TIMESTAMP,
+    start_date TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE RESTRICT
+);
+
+INSERT INTO tasks_new (id, name, notes, project_id, created_at, updated_at, status, completed_at, due_date, start_date)
+SELECT id, name, notes, project_id, created_at, updated_at, status, completed_at, due_date, start_date
+FROM tasks;
+
+DROP TABLE tasks;
+ALTER TABLE tasks_new RENAME TO tasks;
+
+CREATE INDEX idx_tasks_project_id ON tasks(project_id);
+CREATE INDEX idx_tasks_created_at ON tasks(created_at);
+CREATE INDEX idx_tasks_status ON tasks(status);
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at);
+CREATE INDEX idx_tasks_due_date ON tasks(due_date);
+CREATE INDEX idx_tasks_start_date ON tasks(start_date);
+
+-- +goose Down
+DELETE FROM tasks WHERE project_id IS NULL;
+
+CREATE TABLE tasks_old (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    project_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'completed', 'cancelled')),
+    completed_at TIMESTAMP,
+    due_date TIMESTAMP,
+    start_date TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE RESTRICT
+);
+
+INSERT INTO tasks_old (id, name, notes, project_id, created_at, updated_at, status, completed_at, due_date, start_date)
+SELECT id, name, notes, project_id, created_at, updated_at, status, completed_at, due_date, start_date
+FROM tasks;
+
+DROP TABLE tasks;
+ALTER TABLE tasks_old RENAME TO tasks;
+
+CREATE INDEX idx_tasks_project_id ON tasks(project_id);
+CREATE INDEX idx_tasks_created_at ON tasks(created_at);
+CREATE INDEX idx_tasks_status ON tasks(status);
+CREATE INDEX idx_tasks_completed_at ON tasks(completed_at);
+CREATE INDEX idx_tasks_due_date ON tasks(due_date);
+CREATE INDEX idx_tasks_start_date ON tasks(start_date);
@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE tags (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    parent_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (parent_id) REFERENCES tags(id) ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_tags_name ON tags(name);
+CREATE INDEX idx_tags_parent_id ON tags(parent_id);
+CREATE INDEX idx_tags_created_at ON tags(created_at);
+
+CREATE TABLE task_tags (
+    task_id TEXT NOT NULL,
+    tag_id TEXT NOT NULL,
+    PRIMARY KEY (task_id, tag_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_tags_tag_id ON task_tags(tag_id);
+
+CREATE TABLE project_tags (
+    project_id TEXT NOT NULL,
+    tag_id TEXT NOT NULL,
+    PRIMARY KEY (project_id, tag_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_project_tags_tag_id ON project_tags(tag_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_project_tags_tag_id;
+DROP TABLE IF EXISTS project_tags;
+
+DROP INDEX IF EXISTS idx_task_tags_tag_id;
+DROP TABLE IF EXISTS task_tags;
+
+DROP INDEX IF EXISTS idx_tags_created_at;
+DROP INDEX IF EXISTS idx_tags_parent_id;
+DROP INDEX IF EXISTS idx_tags_name;
+DROP TABLE IF EXISTS tags;
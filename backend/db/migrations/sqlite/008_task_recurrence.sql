@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE task_series (
+    id TEXT PRIMARY KEY,
+    frequency TEXT NOT NULL CHECK (frequency IN ('daily', 'weekly', 'monthly', 'yearly')),
+    interval_count INTEGER NOT NULL DEFAULT 1 CHECK (interval_count >= 1),
+    weekdays INTEGER NOT NULL DEFAULT 0,
+    mode TEXT NOT NULL DEFAULT 'fixed' CHECK (mode IN ('fixed', 'after_completion')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE tasks ADD COLUMN series_id TEXT REFERENCES task_series(id);
+
+CREATE INDEX idx_tasks_series_id ON tasks(series_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_series_id;
+
+ALTER TABLE tasks DROP COLUMN series_id;
+
+DROP TABLE IF EXISTS task_series;
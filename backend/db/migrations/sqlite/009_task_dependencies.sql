@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE task_dependencies (
+    task_id TEXT NOT NULL,
+    depends_on_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, depends_on_id),
+    CHECK (task_id != depends_on_id),
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (depends_on_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_dependencies_depends_on_id ON task_dependencies(depends_on_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_task_dependencies_depends_on_id;
+DROP TABLE IF EXISTS task_dependencies;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE headings (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    sort_order REAL NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_headings_project_id ON headings(project_id);
+CREATE INDEX idx_headings_sort_order ON headings(sort_order);
+
+ALTER TABLE tasks ADD COLUMN heading_id TEXT REFERENCES headings(id);
+
+CREATE INDEX idx_tasks_heading_id ON tasks(heading_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_heading_id;
+
+ALTER TABLE tasks DROP COLUMN heading_id;
+
+DROP INDEX IF EXISTS idx_headings_sort_order;
+DROP INDEX IF EXISTS idx_headings_project_id;
+DROP TABLE IF EXISTS headings;
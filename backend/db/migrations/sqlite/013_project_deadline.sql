@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE projects ADD COLUMN deadline TIMESTAMP;
+
+CREATE INDEX idx_projects_deadline ON projects(deadline);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_projects_deadline;
+
+ALTER TABLE projects DROP COLUMN deadline;
@@ -0,0 +1,109 @@
+-- +goose Up
+-- search_documents maps each indexed area, project and task to the rowid of its
+-- row in search_index. The FTS5 table keeps its own copy of the text, so
+-- triggers keep both in step with the source tables.
+CREATE TABLE search_documents (
+    id INTEGER PRIMARY KEY,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('area', 'project', 'task')),
+    entity_id TEXT NOT NULL,
+    UNIQUE (entity_type, entity_id)
+);
+
+CREATE VIRTUAL TABLE search_index USING fts5(
+    name,
+    notes,
+    tokenize = 'porter unicode61 remove_diacritics 2'
+);
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_search_insert AFTER INSERT ON areas BEGIN
+    INSERT INTO search_documents (entity_type, entity_id) VALUES ('area', new.id);
+    INSERT INTO search_index (rowid, name, notes) VALUES (last_insert_rowid(), new.name, COALESCE(new.description, ''));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_search_update AFTER UPDATE OF name, description ON areas BEGIN
+    UPDATE search_index SET name = new.name, notes = COALESCE(new.description, '')
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'area' AND entity_id = new.id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_search_delete AFTER DELETE ON areas BEGIN
+    DELETE FROM search_index
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'area' AND entity_id = old.id);
+    DELETE FROM search_documents WHERE entity_type = 'area' AND entity_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_search_insert AFTER INSERT ON projects BEGIN
+    INSERT INTO search_documents (entity_type, entity_id) VALUES ('project', new.id);
+    INSERT INTO search_index (rowid, name, notes) VALUES (last_insert_rowid(), new.name, new.notes);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_search_update AFTER UPDATE OF name, notes ON projects BEGIN
+    UPDATE search_index SET name = new.name, notes = new.notes
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'project' AND entity_id = new.id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_search_delete AFTER DELETE ON projects BEGIN
+    DELETE FROM search_index
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'project' AND entity_id = old.id);
+    DELETE FROM search_documents WHERE entity_type = 'project' AND entity_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_search_insert AFTER INSERT ON tasks BEGIN
+    INSERT INTO search_documents (entity_type, entity_id) VALUES ('task', new.id);
+    INSERT INTO search_index (rowid, name, notes) VALUES (last_insert_rowid(), new.name, new.notes);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_search_update AFTER UPDATE OF name, notes ON tasks BEGIN
+    UPDATE search_index SET name = new.name, notes = new.notes
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'task' AND entity_id = new.id);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_search_delete AFTER DELETE ON tasks BEGIN
+    DELETE FROM search_index
+    WHERE rowid = (SELECT id FROM search_documents WHERE entity_type = 'task' AND entity_id = old.id);
+    DELETE FROM search_documents WHERE entity_type = 'task' AND entity_id = old.id;
+END;
+-- +goose StatementEnd
+
+-- Index what is already there
+INSERT INTO search_documents (entity_type, entity_id)
+SELECT 'area', id FROM areas
+UNION ALL SELECT 'project', id FROM projects
+UNION ALL SELECT 'task', id FROM tasks;
+
+INSERT INTO search_index (rowid, name, notes)
+SELECT d.id, a.name, COALESCE(a.description, '') FROM search_documents d JOIN areas a ON d.entity_type = 'area' AND a.id = d.entity_id
+UNION ALL
+SELECT d.id, p.name, p.notes FROM search_documents d JOIN projects p ON d.entity_type = 'project' AND p.id = d.entity_id
+UNION ALL
+SELECT d.id, t.name, t.notes FROM search_documents d JOIN tasks t ON d.entity_type = 'task' AND t.id = d.entity_id;
+
+-- +goose Down
+DROP TRIGGER IF EXISTS tasks_search_delete;
+DROP TRIGGER IF EXISTS tasks_search_update;
+DROP TRIGGER IF EXISTS tasks_search_insert;
+DROP TRIGGER IF EXISTS projects_search_delete;
+DROP TRIGGER IF EXISTS projects_search_update;
+DROP TRIGGER IF EXISTS projects_search_insert;
+DROP TRIGGER IF EXISTS areas_search_delete;
+DROP TRIGGER IF EXISTS areas_search_update;
+DROP TRIGGER IF EXISTS areas_search_insert;
+
+DROP TABLE IF EXISTS search_index;
+DROP TABLE IF EXISTS search_documents;
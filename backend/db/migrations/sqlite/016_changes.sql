@@ -0,0 +1,199 @@
+-- +goose Up
+-- changes is an append-only log of every create, update and delete of an
+-- area, project or task, in commit order. Its id is the cursor that watchers
+-- resume from, so AUTOINCREMENT keeps ids from being reused once old entries
+-- are pruned.
+CREATE TABLE changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_type TEXT NOT NULL CHECK (entity_type IN ('area', 'project', 'task')),
+    entity_id TEXT NOT NULL,
+    operation TEXT NOT NULL CHECK (operation IN ('create', 'update', 'delete')),
+    changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Moving an item to the trash reads as a delete and restoring it as a create;
+-- edits to items that stay in the trash are not recorded
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_changes_insert AFTER INSERT ON areas BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('area', new.id, 'create');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_changes_update AFTER UPDATE ON areas
+WHEN old.deleted_at IS NULL OR new.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('area', new.id, CASE
+        WHEN new.deleted_at IS NOT NULL THEN 'delete'
+        WHEN old.deleted_at IS NOT NULL THEN 'create'
+        ELSE 'update'
+    END);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER areas_changes_delete AFTER DELETE ON areas
+WHEN old.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('area', old.id, 'delete');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_changes_insert AFTER INSERT ON projects BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', new.id, 'create');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_changes_update AFTER UPDATE ON projects
+WHEN old.deleted_at IS NULL OR new.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', new.id, CASE
+        WHEN new.deleted_at IS NOT NULL THEN 'delete'
+        WHEN old.deleted_at IS NOT NULL THEN 'create'
+        ELSE 'update'
+    END);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER projects_changes_delete AFTER DELETE ON projects
+WHEN old.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', old.id, 'delete');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_changes_insert AFTER INSERT ON tasks BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.id, 'create');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_changes_update AFTER UPDATE ON tasks
+WHEN old.deleted_at IS NULL OR new.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.id, CASE
+        WHEN new.deleted_at IS NOT NULL THEN 'delete'
+        WHEN old.deleted_at IS NOT NULL THEN 'create'
+        ELSE 'update'
+    END);
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_changes_delete AFTER DELETE ON tasks
+WHEN old.deleted_at IS NULL BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', old.id, 'delete');
+END;
+-- +goose StatementEnd
+
+-- Checklists, tags, dependencies and headings are part of the task or project
+-- they belong to, so changing them updates their owner
+
+-- +goose StatementBegin
+CREATE TRIGGER checklist_items_changes_insert AFTER INSERT ON checklist_items
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = new.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER checklist_items_changes_update AFTER UPDATE ON checklist_items
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = new.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER checklist_items_changes_delete AFTER DELETE ON checklist_items
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = old.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', old.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_tags_changes_insert AFTER INSERT ON task_tags
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = new.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_tags_changes_delete AFTER DELETE ON task_tags
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = old.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', old.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_dependencies_changes_insert AFTER INSERT ON task_dependencies
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = new.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', new.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_dependencies_changes_delete AFTER DELETE ON task_dependencies
+WHEN EXISTS (SELECT 1 FROM tasks WHERE id = old.task_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('task', old.task_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER project_tags_changes_insert AFTER INSERT ON project_tags
+WHEN EXISTS (SELECT 1 FROM projects WHERE id = new.project_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', new.project_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER project_tags_changes_delete AFTER DELETE ON project_tags
+WHEN EXISTS (SELECT 1 FROM projects WHERE id = old.project_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', old.project_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER headings_changes_insert AFTER INSERT ON headings
+WHEN EXISTS (SELECT 1 FROM projects WHERE id = new.project_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', new.project_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER headings_changes_update AFTER UPDATE ON headings
+WHEN EXISTS (SELECT 1 FROM projects WHERE id = new.project_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', new.project_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER headings_changes_delete AFTER DELETE ON headings
+WHEN EXISTS (SELECT 1 FROM projects WHERE id = old.project_id AND deleted_at IS NULL) BEGIN
+    INSERT INTO changes (entity_type, entity_id, operation) VALUES ('project', old.project_id, 'update');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS headings_changes_delete;
+DROP TRIGGER IF EXISTS headings_changes_update;
+DROP TRIGGER IF EXISTS headings_changes_insert;
+DROP TRIGGER IF EXISTS project_tags_changes_delete;
+DROP TRIGGER IF EXISTS project_tags_changes_insert;
+DROP TRIGGER IF EXISTS task_dependencies_changes_delete;
+DROP TRIGGER IF EXISTS task_dependencies_changes_insert;
+DROP TRIGGER IF EXISTS task_tags_changes_delete;
+DROP TRIGGER IF EXISTS task_tags_changes_insert;
+DROP TRIGGER IF EXISTS checklist_items_changes_delete;
+DROP TRIGGER IF EXISTS checklist_items_changes_update;
+DROP TRIGGER IF EXISTS checklist_items_changes_insert;
+DROP TRIGGER IF EXISTS tasks_changes_delete;
+DROP TRIGGER IF EXISTS tasks_changes_update;
+DROP TRIGGER IF EXISTS tasks_changes_insert;
+DROP TRIGGER IF EXISTS projects_changes_delete;
+DROP TRIGGER IF EXISTS projects_changes_update;
+DROP TRIGGER IF EXISTS projects_changes_insert;
+DROP TRIGGER IF EXISTS areas_changes_delete;
+DROP TRIGGER IF EXISTS areas_changes_update;
+DROP TRIGGER IF EXISTS areas_changes_insert;
+
+DROP TABLE IF EXISTS changes;
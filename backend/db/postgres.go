@@ -24,5 +24,9 @@ func OpenPostgreSQL(connString string) (*Store, error) {
 		return nil, fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	return NewStore(db), nil
+	// Serializable transactions turn the check-then-write races that handlers
+	// rely on WithTx to prevent into serialization failures, which are retried
+	store := newStore(db, newPostgresQueries)
+	store.txOptions = &sql.TxOptions{Isolation: sql.LevelSerializable}
+	return store, nil
 }
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"os"
+	"testing"
+)
+
+// openTestPostgres opens the PostgreSQL database named by PLANNER_TEST_POSTGRES,
+// skipping the test when it is not set. The database is migrated and shared
+// between tests, so tests must not depend on it being empty.
+func openTestPostgres(t *testing.T) *Store {
+	t.Helper()
+
+	connString := os.Getenv("PLANNER_TEST_POSTGRES")
+	if connString == "" {
+		t.Skip("PLANNER_TEST_POSTGRES is not set")
+	}
+
+	store, err := OpenPostgreSQL(connString)
+	if err != nil {
+		t.Fatalf("failed to open postgres: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
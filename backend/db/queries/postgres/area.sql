@@ -0,0 +1,122 @@
+-- name: CreateArea :one
+INSERT INTO areas (
+    id,
+    name,
+    description,
+    sort_order,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, (SELECT COALESCE(MIN(a.sort_order), 1) - 1 FROM areas a), $4, $5
+) RETURNING *;
+
+-- name: GetArea :one
+SELECT * FROM areas
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListAreas :many
+SELECT areas.* FROM areas
+CROSS JOIN (SELECT sqlc.arg('manual_order')::boolean AS manual) list_order
+WHERE deleted_at IS NULL
+  AND (sqlc.arg('include_archived')::boolean OR archived_at IS NULL)
+  AND (CASE WHEN list_order.manual
+            THEN sqlc.narg('cursor_sort_order')::double precision IS NULL
+                 OR sort_order > sqlc.narg('cursor_sort_order')
+                 OR (sort_order = sqlc.narg('cursor_sort_order') AND id > sqlc.narg('cursor_id')::text)
+            ELSE sqlc.narg('cursor_created_at')::timestamptz IS NULL
+                 OR created_at < sqlc.narg('cursor_created_at')
+                 OR (created_at = sqlc.narg('cursor_created_at') AND id < sqlc.narg('cursor_id'))
+       END)
+-- Manual order is sort_order then id ascending; otherwise newest first
+ORDER BY
+    CASE WHEN list_order.manual THEN sort_order END ASC,
+    CASE WHEN list_order.manual THEN id END ASC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg('limit')::bigint;
+
+-- name: UpdateArea :one
+UPDATE areas
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    description = COALESCE(sqlc.narg('description'), description),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND version = COALESCE(sqlc.narg('expected_version'), version)
+RETURNING *;
+
+-- name: SetAreaArchived :one
+-- Archiving an area that is already archived keeps its original archived_at
+UPDATE areas
+SET
+    archived_at = CASE WHEN sqlc.arg('archived')::boolean THEN COALESCE(archived_at, sqlc.arg('updated_at')) ELSE NULL END,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashArea :exec
+UPDATE areas
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: GetTrashedArea :one
+SELECT * FROM areas
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: RestoreArea :exec
+UPDATE areas
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1;
+
+-- name: PurgeAreas :execrows
+DELETE FROM areas
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR deleted_at < sqlc.narg('deleted_before'));
+
+-- name: CountProjectsInArea :one
+SELECT COUNT(*)
+FROM projects
+WHERE area_id = $1 AND deleted_at IS NULL;
+
+-- name: CountTasksInArea :one
+SELECT COUNT(*)
+FROM tasks
+WHERE deleted_at IS NULL
+  AND project_id IN (SELECT id FROM projects WHERE area_id = $1);
+
+-- name: AreaExists :one
+SELECT EXISTS (
+    SELECT 1 FROM areas
+    WHERE id = $1 AND deleted_at IS NULL
+);
+
+-- name: SetAreaSortOrder :exec
+UPDATE areas
+SET sort_order = sqlc.arg('sort_order'), updated_at = sqlc.arg('updated_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: AreaSortOrderAfter :one
+-- Returns the first sort key after the given one, for placing an item behind it
+SELECT sort_order FROM areas
+WHERE sort_order > sqlc.arg('sort_order')
+  AND deleted_at IS NULL
+ORDER BY sort_order
+LIMIT 1;
+
+-- name: AreaSortOrderBefore :one
+-- Returns the last sort key before the given one, for placing an item in front of it
+SELECT sort_order FROM areas
+WHERE sort_order < sqlc.arg('sort_order')
+  AND deleted_at IS NULL
+ORDER BY sort_order DESC
+LIMIT 1;
+
+-- name: ListAreaIDsBySortOrder :many
+-- Lists the areas in manual order, for renumbering; trashed ones keep their keys
+SELECT id FROM areas
+WHERE deleted_at IS NULL
+ORDER BY sort_order, id;
@@ -0,0 +1,33 @@
+-- name: AddTaskDependency :exec
+INSERT INTO task_dependencies (task_id, depends_on_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (task_id, depends_on_id) DO NOTHING;
+
+-- name: DeleteTaskDependency :execrows
+DELETE FROM task_dependencies
+WHERE task_id = $1 AND depends_on_id = $2;
+
+-- name: ListDependencyEdges :many
+-- Includes edges to trashed tasks, which come back into play when restored
+SELECT task_id, depends_on_id FROM task_dependencies
+WHERE task_id = ANY(sqlc.arg('task_ids')::text[]);
+
+-- name: ListTaskDependencies :many
+SELECT d.task_id, d.depends_on_id, t.status
+FROM task_dependencies d
+JOIN tasks t ON t.id = d.depends_on_id
+WHERE d.task_id = ANY(sqlc.arg('task_ids')::text[])
+  AND t.deleted_at IS NULL
+ORDER BY d.task_id, d.created_at, d.depends_on_id;
+
+-- name: PurgeTaskDependencies :exec
+DELETE FROM task_dependencies
+WHERE task_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR t.deleted_at < sqlc.narg('deleted_before'))
+) OR depends_on_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR t.deleted_at < sqlc.narg('deleted_before'))
+);
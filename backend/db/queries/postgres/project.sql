@@ -0,0 +1,178 @@
+-- name: CreateProject :one
+-- New projects go to the top of their area
+INSERT INTO projects (
+    id,
+    name,
+    area_id,
+    notes,
+    deadline,
+    sort_order,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5,
+    (SELECT COALESCE(MIN(p.sort_order), 1) - 1 FROM projects p
+     WHERE p.area_id = $3 AND p.deleted_at IS NULL),
+    $6, $7
+) RETURNING *;
+
+-- name: GetProject :one
+SELECT * FROM projects
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListProjects :many
+-- tag_ids is a comma-separated list of tag IDs; tag_min_matches is how many of
+-- them an item must carry (0 disables the tag filter).
+SELECT projects.* FROM projects
+CROSS JOIN (SELECT sqlc.arg('manual_order')::boolean AS manual) list_order
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('area_id')::text IS NULL OR area_id = sqlc.narg('area_id'))
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.arg('include_archived')::boolean
+       OR area_id NOT IN (SELECT a.id FROM areas a WHERE a.archived_at IS NOT NULL))
+  AND (sqlc.arg('tag_min_matches')::bigint = 0
+       OR id IN (SELECT pt.project_id FROM project_tags pt
+                 WHERE pt.tag_id = ANY(string_to_array(sqlc.narg('tag_ids')::text, ','))
+                 GROUP BY pt.project_id
+                 HAVING COUNT(*) >= sqlc.arg('tag_min_matches')::bigint))
+  AND (CASE WHEN list_order.manual
+            THEN sqlc.narg('cursor_sort_order')::double precision IS NULL
+                 OR sort_order > sqlc.narg('cursor_sort_order')
+                 OR (sort_order = sqlc.narg('cursor_sort_order') AND projects.id > sqlc.narg('cursor_id')::text)
+            ELSE sqlc.narg('cursor_created_at')::timestamptz IS NULL
+                 OR created_at < sqlc.narg('cursor_created_at')
+                 OR (created_at = sqlc.narg('cursor_created_at') AND projects.id < sqlc.narg('cursor_id'))
+       END)
+-- Manual order is sort_order then id ascending; otherwise newest first
+ORDER BY
+    CASE WHEN list_order.manual THEN sort_order END ASC,
+    CASE WHEN list_order.manual THEN id END ASC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg('limit')::bigint;
+
+-- name: UpdateProject :one
+UPDATE projects
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    notes = COALESCE(sqlc.narg('notes'), notes),
+    area_id = COALESCE(sqlc.narg('area_id'), area_id),
+    deadline = CASE WHEN sqlc.arg('clear_deadline')::boolean THEN NULL ELSE COALESCE(sqlc.narg('deadline'), deadline) END,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND version = COALESCE(sqlc.narg('expected_version'), version)
+RETURNING *;
+
+-- name: SetProjectStatus :one
+UPDATE projects
+SET
+    status = sqlc.arg('status'),
+    completed_at = sqlc.narg('completed_at'),
+    archived_at = sqlc.narg('archived_at'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashProject :exec
+UPDATE projects
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: TrashProjectsInArea :exec
+UPDATE projects
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE area_id = sqlc.arg('area_id') AND deleted_at IS NULL;
+
+-- name: GetTrashedProject :one
+SELECT * FROM projects
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: RestoreProject :exec
+UPDATE projects
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1;
+
+-- name: RestoreProjectsInArea :exec
+UPDATE projects
+SET deleted_at = NULL, version = version + 1
+WHERE area_id = sqlc.arg('area_id')
+  AND deleted_at = (SELECT a.deleted_at FROM areas a WHERE a.id = sqlc.arg('area_id'));
+
+-- name: PurgeProjectTags :exec
+DELETE FROM project_tags
+WHERE project_id IN (
+    SELECT p.id FROM projects p
+    WHERE p.deleted_at IS NOT NULL
+      AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR p.deleted_at < sqlc.narg('deleted_before'))
+);
+
+-- name: PurgeProjects :execrows
+DELETE FROM projects
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR deleted_at < sqlc.narg('deleted_before'));
+
+-- name: ReassignProjects :exec
+-- Trashed projects stay with the area they were trashed from
+UPDATE projects
+SET
+    area_id = sqlc.arg('new_area_id'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE area_id = sqlc.arg('old_area_id') AND deleted_at IS NULL;
+
+-- name: CountTasksInProject :one
+SELECT COUNT(*)
+FROM tasks
+WHERE project_id = $1 AND deleted_at IS NULL;
+
+-- name: ProjectExists :one
+SELECT EXISTS (
+    SELECT 1 FROM projects
+    WHERE id = $1 AND deleted_at IS NULL
+);
+
+-- name: SetProjectSortOrder :exec
+UPDATE projects
+SET sort_order = sqlc.arg('sort_order'), updated_at = sqlc.arg('updated_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: ProjectSortOrderAfter :one
+-- Returns the first sort key after the given one, for placing an item behind it
+SELECT sort_order FROM projects
+WHERE sort_order > sqlc.arg('sort_order')
+  AND area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order
+LIMIT 1;
+
+-- name: ProjectSortOrderBefore :one
+-- Returns the last sort key before the given one, for placing an item in front of it
+SELECT sort_order FROM projects
+WHERE sort_order < sqlc.arg('sort_order')
+  AND area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order DESC
+LIMIT 1;
+
+-- name: ListProjectIDsBySortOrder :many
+-- Lists an area's projects in manual order, for renumbering; trashed ones keep their keys
+SELECT id FROM projects
+WHERE area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order, id;
+
+-- name: ListProjectProgress :many
+-- Counts the tasks of each project by status; cancelled and trashed tasks are not counted
+SELECT
+    project_id,
+    COUNT(*) FILTER (WHERE status = 'open') AS open_tasks,
+    COUNT(*) FILTER (WHERE status = 'completed') AS completed_tasks,
+    COUNT(*) FILTER (WHERE status = 'open' AND due_date < sqlc.arg('now')) AS overdue_tasks
+FROM tasks
+WHERE deleted_at IS NULL
+  AND project_id = ANY(sqlc.arg('project_ids')::text[])
+GROUP BY project_id;
@@ -0,0 +1,49 @@
+-- name: Search :many
+-- query is one or more words separated by single spaces, the last of which
+-- also matches longer words it is the start of; entity_types is a
+-- comma-separated list of entity types, or NULL for all. The status filters
+-- only apply to results of their own type. Matches in names rank above
+-- matches in notes. Matched terms in the snippet are surrounded by chr(2) and
+-- chr(3) (db.SnippetMatchStart and db.SnippetMatchEnd).
+WITH search AS (
+    SELECT to_tsquery('english', replace(sqlc.arg('query')::text, ' ', ' & ') || ':*') AS query
+), documents AS (
+    SELECT 'task'::text AS entity_type, t.id AS entity_id, t.name, t.notes,
+           search_vector(t.name, t.notes) AS document,
+           a.id AS area_id, p.id AS project_id, t.status, t.deleted_at, a.archived_at
+    FROM tasks t
+    LEFT JOIN projects p ON p.id = t.project_id
+    LEFT JOIN areas a ON a.id = p.area_id
+    UNION ALL
+    SELECT 'project', p.id, p.name, p.notes,
+           search_vector(p.name, p.notes),
+           a.id, p.id, p.status, p.deleted_at, a.archived_at
+    FROM projects p
+    LEFT JOIN areas a ON a.id = p.area_id
+    UNION ALL
+    SELECT 'area', a.id, a.name, COALESCE(a.description, ''),
+           search_vector(a.name, a.description),
+           a.id, NULL, NULL, a.deleted_at, a.archived_at
+    FROM areas a
+)
+SELECT
+    d.entity_type,
+    d.entity_id,
+    d.name,
+    ts_headline('english',
+                CASE WHEN to_tsvector('english', d.notes) @@ s.query THEN d.notes ELSE d.name END,
+                s.query,
+                'StartSel=' || chr(2) || ', StopSel=' || chr(3) || ', FragmentDelimiter=…, MaxFragments=1, MaxWords=16, MinWords=4')::text AS snippet,
+    d.area_id,
+    d.project_id
+FROM documents d
+CROSS JOIN search s
+WHERE d.document @@ s.query
+  AND d.deleted_at IS NULL
+  AND (sqlc.narg('entity_types')::text IS NULL OR d.entity_type = ANY(string_to_array(sqlc.narg('entity_types'), ',')))
+  AND (sqlc.narg('area_id')::text IS NULL OR d.area_id = sqlc.narg('area_id'))
+  AND (sqlc.narg('task_status')::text IS NULL OR d.entity_type != 'task' OR d.status = sqlc.narg('task_status'))
+  AND (sqlc.narg('project_status')::text IS NULL OR d.entity_type != 'project' OR d.status = sqlc.narg('project_status'))
+  AND (sqlc.arg('include_archived')::boolean OR d.archived_at IS NULL)
+ORDER BY ts_rank(d.document, s.query) DESC, d.entity_id
+LIMIT sqlc.arg('limit')::bigint;
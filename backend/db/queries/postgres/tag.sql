@@ -0,0 +1,98 @@
+-- name: CreateTag :one
+INSERT INTO tags (
+    id,
+    name,
+    parent_id,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetTag :one
+SELECT * FROM tags
+WHERE id = $1;
+
+-- name: ListTags :many
+SELECT * FROM tags
+WHERE (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+       OR created_at < sqlc.narg('cursor_created_at')
+       OR (created_at = sqlc.narg('cursor_created_at') AND id < sqlc.narg('cursor_id')::text))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit')::bigint;
+
+-- name: UpdateTag :one
+UPDATE tags
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    parent_id = CASE WHEN sqlc.arg('set_parent')::boolean THEN sqlc.narg('parent_id') ELSE parent_id END,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = $1;
+
+-- name: ReparentTags :exec
+UPDATE tags
+SET
+    parent_id = sqlc.narg('new_parent_id'),
+    updated_at = sqlc.arg('updated_at')
+WHERE parent_id = sqlc.arg('old_parent_id');
+
+-- name: TagExists :one
+SELECT EXISTS (
+    SELECT 1 FROM tags
+    WHERE id = $1
+);
+
+-- name: TagNameTaken :one
+SELECT EXISTS (
+    SELECT 1 FROM tags
+    WHERE name = sqlc.arg('name') AND id != sqlc.arg('id')
+);
+
+-- name: ListExistingTagIDs :many
+SELECT id FROM tags
+WHERE id = ANY(sqlc.arg('ids')::text[]);
+
+-- name: ListTaskTags :many
+SELECT task_id, tag_id FROM task_tags
+WHERE task_id = ANY(sqlc.arg('task_ids')::text[])
+ORDER BY task_id, tag_id;
+
+-- name: AddTaskTag :exec
+INSERT INTO task_tags (task_id, tag_id)
+VALUES ($1, $2);
+
+-- name: CopyTaskTags :exec
+INSERT INTO task_tags (task_id, tag_id)
+SELECT sqlc.arg('to_task_id')::text, tt.tag_id
+FROM task_tags tt
+WHERE tt.task_id = sqlc.arg('from_task_id');
+
+-- name: DeleteTaskTags :exec
+DELETE FROM task_tags
+WHERE task_id = $1;
+
+-- name: DeleteTaskTagsForTag :exec
+DELETE FROM task_tags
+WHERE tag_id = $1;
+
+-- name: ListProjectTags :many
+SELECT project_id, tag_id FROM project_tags
+WHERE project_id = ANY(sqlc.arg('project_ids')::text[])
+ORDER BY project_id, tag_id;
+
+-- name: AddProjectTag :exec
+INSERT INTO project_tags (project_id, tag_id)
+VALUES ($1, $2);
+
+-- name: DeleteProjectTags :exec
+DELETE FROM project_tags
+WHERE project_id = $1;
+
+-- name: DeleteProjectTagsForTag :exec
+DELETE FROM project_tags
+WHERE tag_id = $1;
@@ -0,0 +1,245 @@
+-- name: CreateTask :one
+-- New tasks go to the top of their project, or of the Inbox
+INSERT INTO tasks (
+    id,
+    name,
+    notes,
+    project_id,
+    due_date,
+    start_date,
+    series_id,
+    heading_id,
+    sort_order,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8,
+    (SELECT COALESCE(MIN(t.sort_order), 1) - 1 FROM tasks t
+     WHERE t.project_id IS NOT DISTINCT FROM $4 AND t.deleted_at IS NULL),
+    $9, $10
+) RETURNING *;
+
+-- name: GetTask :one
+SELECT * FROM tasks
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListTasks :many
+-- tag_ids is a comma-separated list of tag IDs; tag_min_matches is how many of
+-- them an item must carry (0 disables the tag filter).
+SELECT tasks.* FROM tasks
+CROSS JOIN (SELECT sqlc.arg('manual_order')::boolean AS manual) list_order
+WHERE deleted_at IS NULL
+  AND (sqlc.narg('project_id')::text IS NULL OR project_id = sqlc.narg('project_id'))
+  AND (sqlc.arg('inbox_only')::boolean = FALSE OR project_id IS NULL)
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+  AND (sqlc.arg('include_archived')::boolean
+       OR project_id IS NULL
+       OR project_id NOT IN (SELECT p.id FROM projects p JOIN areas a ON a.id = p.area_id WHERE a.archived_at IS NOT NULL))
+  AND (sqlc.narg('completed_after')::timestamptz IS NULL OR completed_at >= sqlc.narg('completed_after'))
+  AND (sqlc.narg('completed_before')::timestamptz IS NULL OR completed_at < sqlc.narg('completed_before'))
+  AND (sqlc.narg('due_after')::timestamptz IS NULL OR due_date >= sqlc.narg('due_after'))
+  AND (sqlc.narg('due_before')::timestamptz IS NULL OR due_date < sqlc.narg('due_before'))
+  AND (sqlc.narg('available_at')::timestamptz IS NULL OR start_date IS NULL OR start_date <= sqlc.narg('available_at'))
+  AND (sqlc.arg('tag_min_matches')::bigint = 0
+       OR id IN (SELECT tt.task_id FROM task_tags tt
+                 WHERE tt.tag_id = ANY(string_to_array(sqlc.narg('tag_ids')::text, ','))
+                 GROUP BY tt.task_id
+                 HAVING COUNT(*) >= sqlc.arg('tag_min_matches')::bigint))
+  AND (sqlc.arg('unblocked_only')::boolean = FALSE
+       OR NOT EXISTS (SELECT 1 FROM task_dependencies d
+                      JOIN tasks b ON b.id = d.depends_on_id
+                      WHERE d.task_id = tasks.id AND b.status = 'open' AND b.deleted_at IS NULL))
+  AND (CASE WHEN list_order.manual
+            THEN sqlc.narg('cursor_sort_order')::double precision IS NULL
+                 OR sort_order > sqlc.narg('cursor_sort_order')
+                 OR (sort_order = sqlc.narg('cursor_sort_order') AND tasks.id > sqlc.narg('cursor_id')::text)
+            ELSE sqlc.narg('cursor_created_at')::timestamptz IS NULL
+                 OR created_at < sqlc.narg('cursor_created_at')
+                 OR (created_at = sqlc.narg('cursor_created_at') AND tasks.id < sqlc.narg('cursor_id'))
+       END)
+-- Manual order is sort_order then id ascending; otherwise newest first
+ORDER BY
+    CASE WHEN list_order.manual THEN sort_order END ASC,
+    CASE WHEN list_order.manual THEN id END ASC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg('limit')::bigint;
+
+-- name: UpdateTask :one
+UPDATE tasks
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    notes = COALESCE(sqlc.narg('notes'), notes),
+    due_date = CASE WHEN sqlc.arg('clear_due_date')::boolean THEN NULL ELSE COALESCE(sqlc.narg('due_date'), due_date) END,
+    start_date = CASE WHEN sqlc.arg('clear_start_date')::boolean THEN NULL ELSE COALESCE(sqlc.narg('start_date'), start_date) END,
+    project_id = CASE WHEN sqlc.arg('set_project')::boolean THEN sqlc.narg('project_id') ELSE project_id END,
+    -- A heading belongs to a project, so moving the task elsewhere leaves the heading behind
+    heading_id = CASE
+        WHEN sqlc.arg('set_heading')::boolean THEN sqlc.narg('heading_id')
+        WHEN sqlc.arg('set_project')::boolean AND COALESCE(project_id, '') != COALESCE(sqlc.narg('project_id'), '') THEN NULL
+        ELSE heading_id
+    END,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND version = COALESCE(sqlc.narg('expected_version'), version)
+RETURNING *;
+
+-- name: SetTaskStatus :one
+UPDATE tasks
+SET
+    status = sqlc.arg('status'),
+    completed_at = sqlc.narg('completed_at'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: FinishProjectTasks :exec
+UPDATE tasks
+SET
+    status = sqlc.arg('status'),
+    completed_at = sqlc.arg('completed_at'),
+    version = version + 1,
+    updated_at = sqlc.arg('completed_at')
+WHERE project_id = sqlc.arg('project_id')
+  AND status = 'open'
+  AND deleted_at IS NULL;
+
+-- name: SetTaskProject :one
+UPDATE tasks
+SET
+    project_id = sqlc.narg('project_id'),
+    heading_id = CASE WHEN COALESCE(project_id, '') = COALESCE(sqlc.narg('project_id'), '') THEN heading_id ELSE NULL END,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetTaskSeries :one
+UPDATE tasks
+SET
+    series_id = sqlc.narg('series_id'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SetTaskDates :one
+UPDATE tasks
+SET
+    due_date = sqlc.narg('due_date'),
+    start_date = sqlc.narg('start_date'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashTask :exec
+UPDATE tasks
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: TrashTasksInProject :exec
+UPDATE tasks
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE project_id = sqlc.arg('project_id') AND deleted_at IS NULL;
+
+-- name: TrashTasksInArea :exec
+UPDATE tasks
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE deleted_at IS NULL
+  AND project_id IN (SELECT p.id FROM projects p WHERE p.area_id = sqlc.arg('area_id') AND p.deleted_at IS NULL);
+
+-- name: GetTrashedTask :one
+SELECT * FROM tasks
+WHERE id = $1 AND deleted_at IS NOT NULL;
+
+-- name: RestoreTask :exec
+UPDATE tasks
+SET deleted_at = NULL, version = version + 1
+WHERE id = $1;
+
+-- name: RestoreTasksInProject :exec
+UPDATE tasks
+SET deleted_at = NULL, version = version + 1
+WHERE project_id = sqlc.arg('project_id')
+  AND deleted_at = (SELECT p.deleted_at FROM projects p WHERE p.id = sqlc.arg('project_id'));
+
+-- name: RestoreTasksInArea :exec
+UPDATE tasks
+SET deleted_at = NULL, version = version + 1
+WHERE deleted_at = (SELECT a.deleted_at FROM areas a WHERE a.id = sqlc.arg('area_id'))
+  AND project_id IN (SELECT p.id FROM projects p WHERE p.area_id = sqlc.arg('area_id'));
+
+-- name: PurgeTaskTags :exec
+DELETE FROM task_tags
+WHERE task_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR t.deleted_at < sqlc.narg('deleted_before'))
+);
+
+-- name: PurgeTasks :execrows
+DELETE FROM tasks
+WHERE deleted_at IS NOT NULL
+  AND (sqlc.narg('deleted_before')::timestamptz IS NULL OR deleted_at < sqlc.narg('deleted_before'));
+
+-- name: ReassignTasks :exec
+-- Trashed tasks stay with the project they were trashed from
+UPDATE tasks
+SET
+    project_id = sqlc.narg('new_project_id'),
+    heading_id = NULL,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE project_id = sqlc.arg('old_project_id') AND deleted_at IS NULL;
+
+-- name: TaskExists :one
+SELECT EXISTS (
+    SELECT 1 FROM tasks
+    WHERE id = $1 AND deleted_at IS NULL
+);
+
+-- name: SetTaskSortOrder :exec
+UPDATE tasks
+SET sort_order = sqlc.arg('sort_order'), updated_at = sqlc.arg('updated_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: TaskSortOrderAfter :one
+-- Returns the first sort key after the given one, for placing an item behind it
+SELECT sort_order FROM tasks
+WHERE sort_order > sqlc.arg('sort_order')
+  AND project_id IS NOT DISTINCT FROM sqlc.narg('project_id')::text
+  AND deleted_at IS NULL
+ORDER BY sort_order
+LIMIT 1;
+
+-- name: TaskSortOrderBefore :one
+-- Returns the last sort key before the given one, for placing an item in front of it
+SELECT sort_order FROM tasks
+WHERE sort_order < sqlc.arg('sort_order')
+  AND project_id IS NOT DISTINCT FROM sqlc.narg('project_id')::text
+  AND deleted_at IS NULL
+ORDER BY sort_order DESC
+LIMIT 1;
+
+-- name: ListTaskIDsBySortOrder :many
+-- Lists a project's tasks, or the Inbox's, in manual order, for renumbering; trashed ones keep their keys
+SELECT id FROM tasks
+WHERE project_id IS NOT DISTINCT FROM sqlc.narg('project_id')::text
+  AND deleted_at IS NULL
+ORDER BY sort_order, id;
+
+-- name: ListProjectTasks :many
+-- Lists every task of a project in manual order, for grouping under headings
+SELECT * FROM tasks
+WHERE project_id = sqlc.arg('project_id')
+  AND deleted_at IS NULL
+  AND (sqlc.arg('include_finished')::boolean = TRUE OR status = 'open')
+ORDER BY sort_order, id;
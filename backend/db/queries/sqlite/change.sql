@@ -0,0 +1,18 @@
+-- name: ListChanges :many
+-- Lists the changes recorded after a cursor, oldest first
+SELECT * FROM changes
+WHERE id > sqlc.arg('after_id')
+ORDER BY id
+LIMIT sqlc.arg('limit');
+
+-- name: GetChangeBounds :one
+-- Returns the ids of the oldest and newest changes still in the log, or zeros
+-- when it is empty
+SELECT CAST(COALESCE(MIN(id), 0) AS INTEGER) AS oldest_id,
+       CAST(COALESCE(MAX(id), 0) AS INTEGER) AS latest_id
+FROM changes;
+
+-- name: PruneChanges :execrows
+-- Deletes all but the most recent changes
+DELETE FROM changes
+WHERE changes.id <= (SELECT MAX(latest.id) FROM changes latest) - sqlc.arg('keep');
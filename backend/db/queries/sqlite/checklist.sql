@@ -0,0 +1,53 @@
+-- name: CreateChecklistItem :one
+INSERT INTO checklist_items (
+    id,
+    task_id,
+    name,
+    position,
+    created_at,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+) RETURNING *;
+
+-- name: GetChecklistItem :one
+SELECT ci.* FROM checklist_items ci
+WHERE ci.id = ?
+  AND ci.task_id IN (SELECT t.id FROM tasks t WHERE t.deleted_at IS NULL);
+
+-- name: ListChecklistItems :many
+SELECT * FROM checklist_items
+WHERE task_id IN (sqlc.slice('task_ids'))
+ORDER BY task_id, position;
+
+-- name: NextChecklistPosition :one
+SELECT CAST(COALESCE(MAX(position), -1) + 1 AS INTEGER)
+FROM checklist_items
+WHERE task_id = ?;
+
+-- name: SetChecklistItemCompleted :one
+UPDATE checklist_items
+SET
+    completed = sqlc.arg('completed'),
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: SetChecklistItemPosition :exec
+UPDATE checklist_items
+SET
+    position = sqlc.arg('position'),
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id');
+
+-- name: DeleteChecklistItem :exec
+DELETE FROM checklist_items
+WHERE id = ?;
+
+-- name: PurgeChecklistItems :exec
+DELETE FROM checklist_items
+WHERE task_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (t.deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL)
+);
@@ -0,0 +1,33 @@
+-- name: AddTaskDependency :exec
+INSERT INTO task_dependencies (task_id, depends_on_id, created_at)
+VALUES (?, ?, ?)
+ON CONFLICT (task_id, depends_on_id) DO NOTHING;
+
+-- name: DeleteTaskDependency :execrows
+DELETE FROM task_dependencies
+WHERE task_id = ? AND depends_on_id = ?;
+
+-- name: ListDependencyEdges :many
+-- Includes edges to trashed tasks, which come back into play when restored
+SELECT task_id, depends_on_id FROM task_dependencies
+WHERE task_id IN (sqlc.slice('task_ids'));
+
+-- name: ListTaskDependencies :many
+SELECT d.task_id, d.depends_on_id, t.status
+FROM task_dependencies d
+JOIN tasks t ON t.id = d.depends_on_id
+WHERE d.task_id IN (sqlc.slice('task_ids'))
+  AND t.deleted_at IS NULL
+ORDER BY d.task_id, d.created_at, d.depends_on_id;
+
+-- name: PurgeTaskDependencies :exec
+DELETE FROM task_dependencies
+WHERE task_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (t.deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL)
+) OR depends_on_id IN (
+    SELECT t.id FROM tasks t
+    WHERE t.deleted_at IS NOT NULL
+      AND (t.deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL)
+);
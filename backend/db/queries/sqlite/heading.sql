@@ -0,0 +1,99 @@
+-- name: CreateHeading :one
+-- New headings go to the end of the project
+INSERT INTO headings (
+    id,
+    project_id,
+    name,
+    sort_order,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('id'), sqlc.arg('project_id'), sqlc.arg('name'),
+    (SELECT COALESCE(MAX(h.sort_order), -1) + 1 FROM headings h WHERE h.project_id = sqlc.arg('project_id')),
+    sqlc.arg('created_at'), sqlc.arg('updated_at')
+) RETURNING *;
+
+-- name: GetHeading :one
+-- Headings of trashed projects are hidden along with the project
+SELECT h.* FROM headings h
+JOIN projects p ON p.id = h.project_id
+WHERE h.id = ? AND p.deleted_at IS NULL;
+
+-- name: ListHeadings :many
+SELECT * FROM headings
+WHERE project_id = ?
+ORDER BY sort_order, id;
+
+-- name: RenameHeading :one
+UPDATE headings
+SET
+    name = sqlc.arg('name'),
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: MoveHeading :one
+-- Moves a heading to the end of another project
+UPDATE headings
+SET
+    project_id = sqlc.arg('project_id'),
+    sort_order = (SELECT COALESCE(MAX(h.sort_order), -1) + 1 FROM headings h WHERE h.project_id = sqlc.arg('project_id')),
+    updated_at = sqlc.arg('updated_at')
+WHERE headings.id = sqlc.arg('id')
+RETURNING *;
+
+-- name: MoveHeadingTasks :exec
+-- Includes trashed tasks so they are restored into the heading's new project
+UPDATE tasks
+SET
+    project_id = sqlc.arg('project_id'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE heading_id = sqlc.arg('heading_id');
+
+-- name: ClearTaskHeadings :exec
+UPDATE tasks
+SET
+    heading_id = NULL,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE heading_id = sqlc.arg('heading_id');
+
+-- name: DeleteHeading :exec
+DELETE FROM headings
+WHERE id = ?;
+
+-- name: PurgeHeadings :exec
+DELETE FROM headings
+WHERE project_id IN (
+    SELECT p.id FROM projects p
+    WHERE p.deleted_at IS NOT NULL
+      AND (p.deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL)
+);
+
+-- name: SetHeadingSortOrder :exec
+UPDATE headings
+SET sort_order = sqlc.arg('sort_order'), updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id');
+
+-- name: HeadingSortOrderAfter :one
+-- Returns the first sort key after the given one, for placing a heading behind it
+SELECT sort_order FROM headings
+WHERE sort_order > sqlc.arg('sort_order')
+  AND project_id = sqlc.arg('project_id')
+ORDER BY sort_order
+LIMIT 1;
+
+-- name: HeadingSortOrderBefore :one
+-- Returns the last sort key before the given one, for placing a heading in front of it
+SELECT sort_order FROM headings
+WHERE sort_order < sqlc.arg('sort_order')
+  AND project_id = sqlc.arg('project_id')
+ORDER BY sort_order DESC
+LIMIT 1;
+
+-- name: ListHeadingIDsBySortOrder :many
+-- Lists a project's headings in manual order, for renumbering
+SELECT id FROM headings
+WHERE project_id = sqlc.arg('project_id')
+ORDER BY sort_order, id;
@@ -0,0 +1,177 @@
+-- name: CreateProject :one
+-- New projects go to the top of their area
+INSERT INTO projects (
+    id,
+    name,
+    area_id,
+    notes,
+    deadline,
+    sort_order,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('id'), sqlc.arg('name'), sqlc.arg('area_id'), sqlc.arg('notes'), sqlc.narg('deadline'),
+    (SELECT COALESCE(MIN(p.sort_order), 1) - 1 FROM projects p
+     WHERE p.area_id = sqlc.arg('area_id') AND p.deleted_at IS NULL),
+    sqlc.arg('created_at'), sqlc.arg('updated_at')
+) RETURNING *;
+
+-- name: GetProject :one
+SELECT * FROM projects
+WHERE id = ? AND deleted_at IS NULL;
+
+-- name: ListProjects :many
+-- tag_ids is a comma-separated list of tag IDs; tag_min_matches is how many of
+-- them an item must carry (0 disables the tag filter).
+SELECT projects.* FROM projects
+JOIN (SELECT CAST(sqlc.arg('manual_order') AS BOOLEAN) AS manual) list_order
+WHERE deleted_at IS NULL
+  AND (projects.area_id = sqlc.narg('area_id') OR sqlc.narg('area_id') IS NULL)
+  AND (projects.status = sqlc.narg('status') OR sqlc.narg('status') IS NULL)
+  AND (CAST(sqlc.arg('include_archived') AS BOOLEAN)
+       OR area_id NOT IN (SELECT a.id FROM areas a WHERE a.archived_at IS NOT NULL))
+  AND (CAST(sqlc.arg('tag_min_matches') AS INTEGER) = 0
+       OR id IN (SELECT pt.project_id FROM project_tags pt
+                 WHERE ',' || sqlc.arg('tag_ids') || ',' LIKE '%,' || pt.tag_id || ',%'
+                 GROUP BY pt.project_id
+                 HAVING COUNT(*) >= CAST(sqlc.arg('tag_min_matches') AS INTEGER)))
+  AND (CASE WHEN list_order.manual
+            THEN projects.sort_order > sqlc.narg('cursor_sort_order')
+                 OR (projects.sort_order = sqlc.narg('cursor_sort_order') AND projects.id > sqlc.narg('cursor_id'))
+                 OR sqlc.narg('cursor_sort_order') IS NULL
+            ELSE projects.created_at < sqlc.narg('cursor_created_at')
+                 OR (projects.created_at = sqlc.narg('cursor_created_at') AND projects.id < sqlc.narg('cursor_id'))
+                 OR sqlc.narg('cursor_created_at') IS NULL
+       END)
+-- Manual order is sort_order then id ascending; otherwise newest first
+ORDER BY
+    CASE WHEN list_order.manual THEN sort_order END ASC,
+    CASE WHEN list_order.manual THEN id END ASC,
+    created_at DESC,
+    id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateProject :one
+UPDATE projects
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    notes = COALESCE(sqlc.narg('notes'), notes),
+    area_id = COALESCE(sqlc.narg('area_id'), area_id),
+    deadline = CASE WHEN CAST(sqlc.arg('clear_deadline') AS BOOLEAN) THEN NULL ELSE COALESCE(sqlc.narg('deadline'), deadline) END,
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+  AND version = COALESCE(sqlc.narg('expected_version'), version)
+RETURNING *;
+
+-- name: SetProjectStatus :one
+UPDATE projects
+SET
+    status = sqlc.arg('status'),
+    completed_at = sqlc.narg('completed_at'),
+    archived_at = sqlc.narg('archived_at'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: TrashProject :exec
+UPDATE projects
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: TrashProjectsInArea :exec
+UPDATE projects
+SET deleted_at = sqlc.arg('deleted_at'), version = version + 1
+WHERE area_id = sqlc.arg('area_id') AND deleted_at IS NULL;
+
+-- name: GetTrashedProject :one
+SELECT * FROM projects
+WHERE id = ? AND deleted_at IS NOT NULL;
+
+-- name: RestoreProject :exec
+UPDATE projects
+SET deleted_at = NULL, version = version + 1
+WHERE id = ?;
+
+-- name: RestoreProjectsInArea :exec
+UPDATE projects
+SET deleted_at = NULL, version = version + 1
+WHERE area_id = sqlc.arg('area_id')
+  AND deleted_at = (SELECT a.deleted_at FROM areas a WHERE a.id = sqlc.arg('area_id'));
+
+-- name: PurgeProjectTags :exec
+DELETE FROM project_tags
+WHERE project_id IN (
+    SELECT p.id FROM projects p
+    WHERE p.deleted_at IS NOT NULL
+      AND (p.deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL)
+);
+
+-- name: PurgeProjects :execrows
+DELETE FROM projects
+WHERE deleted_at IS NOT NULL
+  AND (deleted_at < sqlc.narg('deleted_before') OR sqlc.narg('deleted_before') IS NULL);
+
+-- name: ReassignProjects :exec
+-- Trashed projects stay with the area they were trashed from
+UPDATE projects
+SET
+    area_id = sqlc.arg('new_area_id'),
+    version = version + 1,
+    updated_at = sqlc.arg('updated_at')
+WHERE area_id = sqlc.arg('old_area_id') AND deleted_at IS NULL;
+
+-- name: CountTasksInProject :one
+SELECT COUNT(*)
+FROM tasks
+WHERE project_id = ? AND deleted_at IS NULL;
+
+-- name: ProjectExists :one
+SELECT COUNT(*) > 0
+FROM projects
+WHERE id = ? AND deleted_at IS NULL;
+
+-- name: SetProjectSortOrder :exec
+UPDATE projects
+SET sort_order = sqlc.arg('sort_order'), updated_at = sqlc.arg('updated_at'), version = version + 1
+WHERE id = sqlc.arg('id');
+
+-- name: ProjectSortOrderAfter :one
+-- Returns the first sort key after the given one, for placing an item behind it
+SELECT sort_order FROM projects
+WHERE sort_order > sqlc.arg('sort_order')
+  AND area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order
+LIMIT 1;
+
+-- name: ProjectSortOrderBefore :one
+-- Returns the last sort key before the given one, for placing an item in front of it
+SELECT sort_order FROM projects
+WHERE sort_order < sqlc.arg('sort_order')
+  AND area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order DESC
+LIMIT 1;
+
+-- name: ListProjectIDsBySortOrder :many
+-- Lists an area's projects in manual order, for renumbering; trashed ones keep their keys
+SELECT id FROM projects
+WHERE area_id = sqlc.arg('area_id')
+  AND deleted_at IS NULL
+ORDER BY sort_order, id;
+
+-- name: ListProjectProgress :many
+-- Counts the tasks of each project by status; cancelled and trashed tasks are not counted
+SELECT
+    project_id,
+    CAST(COALESCE(SUM(CASE WHEN status = 'open' THEN 1 ELSE 0 END), 0) AS INTEGER) AS open_tasks,
+    CAST(COALESCE(SUM(CASE WHEN status = 'completed' THEN 1 ELSE 0 END), 0) AS INTEGER) AS completed_tasks,
+    CAST(COALESCE(SUM(CASE WHEN status = 'open' AND due_date < sqlc.arg('now') THEN 1 ELSE 0 END), 0) AS INTEGER) AS overdue_tasks
+FROM tasks
+WHERE deleted_at IS NULL
+  AND project_id IN (SELECT p.id FROM projects p WHERE p.id IN (sqlc.slice('project_ids')))
+GROUP BY project_id;
@@ -0,0 +1,45 @@
+-- name: CreateTaskSeries :one
+INSERT INTO task_series (
+    id,
+    frequency,
+    interval_count,
+    weekdays,
+    mode,
+    created_at,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?, ?
+) RETURNING *;
+
+-- name: GetTaskSeries :one
+SELECT * FROM task_series
+WHERE id = ?;
+
+-- name: ListTaskSeries :many
+SELECT * FROM task_series
+WHERE id IN (sqlc.slice('ids'));
+
+-- name: UpdateTaskSeries :one
+UPDATE task_series
+SET
+    frequency = sqlc.arg('frequency'),
+    interval_count = sqlc.arg('interval_count'),
+    weekdays = sqlc.arg('weekdays'),
+    mode = sqlc.arg('mode'),
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: SeriesHasOpenTask :one
+-- Reports whether a series has an open occurrence other than the given task
+SELECT COUNT(*) > 0
+FROM tasks
+WHERE series_id = sqlc.arg('series_id')
+  AND id != sqlc.arg('task_id')
+  AND status = 'open'
+  AND deleted_at IS NULL;
+
+-- name: PurgeTaskSeries :exec
+-- Removes series that no longer have any tasks
+DELETE FROM task_series
+WHERE id NOT IN (SELECT t.series_id FROM tasks t WHERE t.series_id IS NOT NULL);
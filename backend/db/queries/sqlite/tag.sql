@@ -0,0 +1,96 @@
+-- name: CreateTag :one
+INSERT INTO tags (
+    id,
+    name,
+    parent_id,
+    created_at,
+    updated_at
+) VALUES (
+    ?, ?, ?, ?, ?
+) RETURNING *;
+
+-- name: GetTag :one
+SELECT * FROM tags
+WHERE id = ?;
+
+-- name: ListTags :many
+SELECT * FROM tags
+WHERE (created_at < sqlc.narg('cursor_created_at')
+       OR (created_at = sqlc.narg('cursor_created_at') AND id < sqlc.narg('cursor_id'))
+       OR sqlc.narg('cursor_created_at') IS NULL)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: UpdateTag :one
+UPDATE tags
+SET
+    name = COALESCE(sqlc.narg('name'), name),
+    parent_id = CASE WHEN CAST(sqlc.arg('set_parent') AS BOOLEAN) THEN sqlc.narg('parent_id') ELSE parent_id END,
+    updated_at = sqlc.arg('updated_at')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags
+WHERE id = ?;
+
+-- name: ReparentTags :exec
+UPDATE tags
+SET
+    parent_id = sqlc.narg('new_parent_id'),
+    updated_at = sqlc.arg('updated_at')
+WHERE parent_id = sqlc.arg('old_parent_id');
+
+-- name: TagExists :one
+SELECT COUNT(*) > 0
+FROM tags
+WHERE id = ?;
+
+-- name: TagNameTaken :one
+SELECT COUNT(*) > 0
+FROM tags
+WHERE name = sqlc.arg('name') AND id != sqlc.arg('id');
+
+-- name: ListExistingTagIDs :many
+SELECT id FROM tags
+WHERE id IN (sqlc.slice('ids'));
+
+-- name: ListTaskTags :many
+SELECT task_id, tag_id FROM task_tags
+WHERE task_id IN (sqlc.slice('task_ids'))
+ORDER BY task_id, tag_id;
+
+-- name: AddTaskTag :exec
+INSERT INTO task_tags (task_id, tag_id)
+VALUES (?, ?);
+
+-- name: CopyTaskTags :exec
+INSERT INTO task_tags (task_id, tag_id)
+SELECT sqlc.arg('to_task_id'), tt.tag_id
+FROM task_tags tt
+WHERE tt.task_id = sqlc.arg('from_task_id');
+
+-- name: DeleteTaskTags :exec
+DELETE FROM task_tags
+WHERE task_id = ?;
+
+-- name: DeleteTaskTagsForTag :exec
+DELETE FROM task_tags
+WHERE tag_id = ?;
+
+-- name: ListProjectTags :many
+SELECT project_id, tag_id FROM project_tags
+WHERE project_id IN (sqlc.slice('project_ids'))
+ORDER BY project_id, tag_id;
+
+-- name: AddProjectTag :exec
+INSERT INTO project_tags (project_id, tag_id)
+VALUES (?, ?);
+
+-- name: DeleteProjectTags :exec
+DELETE FROM project_tags
+WHERE project_id = ?;
+
+-- name: DeleteProjectTagsForTag :exec
+DELETE FROM project_tags
+WHERE tag_id = ?;
@@ -0,0 +1,32 @@
+-- name: ListTrash :many
+-- Lists the top-level items in the trash, most recently deleted first.
+-- Children trashed along with their parent are restored and purged with it,
+-- so only the parent is listed.
+SELECT 'area' AS item_type, a.id, a.name, a.deleted_at
+FROM areas a
+WHERE a.deleted_at IS NOT NULL
+  AND (CAST(sqlc.narg('item_type') AS TEXT) = 'area' OR sqlc.narg('item_type') IS NULL)
+  AND (a.deleted_at < sqlc.narg('cursor_deleted_at')
+       OR (a.deleted_at = sqlc.narg('cursor_deleted_at') AND a.id < sqlc.narg('cursor_id'))
+       OR sqlc.narg('cursor_deleted_at') IS NULL)
+UNION ALL
+SELECT 'project' AS item_type, p.id, p.name, p.deleted_at
+FROM projects p
+WHERE p.deleted_at IS NOT NULL
+  AND p.area_id NOT IN (SELECT ta.id FROM areas ta WHERE ta.deleted_at IS NOT NULL)
+  AND (sqlc.narg('item_type') = 'project' OR sqlc.narg('item_type') IS NULL)
+  AND (p.deleted_at < sqlc.narg('cursor_deleted_at')
+       OR (p.deleted_at = sqlc.narg('cursor_deleted_at') AND p.id < sqlc.narg('cursor_id'))
+       OR sqlc.narg('cursor_deleted_at') IS NULL)
+UNION ALL
+SELECT 'task' AS item_type, t.id, t.name, t.deleted_at
+FROM tasks t
+WHERE t.deleted_at IS NOT NULL
+  AND (t.project_id IS NULL
+       OR t.project_id NOT IN (SELECT tp.id FROM projects tp WHERE tp.deleted_at IS NOT NULL))
+  AND (sqlc.narg('item_type') = 'task' OR sqlc.narg('item_type') IS NULL)
+  AND (t.deleted_at < sqlc.narg('cursor_deleted_at')
+       OR (t.deleted_at = sqlc.narg('cursor_deleted_at') AND t.id < sqlc.narg('cursor_id'))
+       OR sqlc.narg('cursor_deleted_at') IS NULL)
+ORDER BY deleted_at DESC, id DESC
+LIMIT sqlc.arg('limit');
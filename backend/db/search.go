@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// search is written by hand rather than generated: sqlc cannot resolve the
+// hidden column FTS5 puts on the left-hand side of MATCH. The PostgreSQL
+// version is generated as usual from queries/postgres/search.sql.
+//
+// query is an FTS5 query string; entity_types is a comma-separated list of
+// entity types, or NULL for all. The status filters only apply to results of
+// their own type. Matches in names rank above matches in notes.
+const search = `-- name: Search :many
+SELECT
+    d.entity_type,
+    d.entity_id,
+    search_index.name,
+    snippet(search_index, -1, char(2), char(3), '…', 16) AS snippet,
+    a.id AS area_id,
+    p.id AS project_id
+FROM search_index
+JOIN search_documents d ON d.id = search_index.rowid
+LEFT JOIN tasks t ON d.entity_type = 'task' AND t.id = d.entity_id
+LEFT JOIN projects p ON p.id = CASE d.entity_type WHEN 'project' THEN d.entity_id WHEN 'task' THEN t.project_id END
+LEFT JOIN areas a ON a.id = CASE d.entity_type WHEN 'area' THEN d.entity_id ELSE p.area_id END
+WHERE search_index MATCH ?1
+  AND (CASE d.entity_type WHEN 'area' THEN a.deleted_at WHEN 'project' THEN p.deleted_at ELSE t.deleted_at END) IS NULL
+  AND (?2 IS NULL OR ',' || ?2 || ',' LIKE '%,' || d.entity_type || ',%')
+  AND (?3 IS NULL OR a.id = ?3)
+  AND (?4 IS NULL OR d.entity_type != 'task' OR t.status = ?4)
+  AND (?5 IS NULL OR d.entity_type != 'project' OR p.status = ?5)
+  AND (CAST(?6 AS BOOLEAN) OR a.archived_at IS NULL)
+ORDER BY bm25(search_index, 10.0, 1.0), d.entity_id
+LIMIT ?7
+`
+
+// SnippetMatchStart and SnippetMatchEnd surround the matched terms in the
+// Snippet of a SearchRow, in both databases. They are control characters
+// rather than markup, so the text around them is as stored and the caller
+// escapes it for whatever it renders.
+const (
+	SnippetMatchStart = "\x02"
+	SnippetMatchEnd   = "\x03"
+)
+
+// SearchParams matches the parameters sqlc generates for the PostgreSQL query.
+// Query is one or more words separated by single spaces, the last of which
+// also matches longer words it is the start of.
+type SearchParams struct {
+	EntityTypes     sql.NullString `json:"entity_types"`
+	AreaID          sql.NullString `json:"area_id"`
+	TaskStatus      sql.NullString `json:"task_status"`
+	ProjectStatus   sql.NullString `json:"project_status"`
+	IncludeArchived bool           `json:"include_archived"`
+	Limit           int64          `json:"limit"`
+	Query           string         `json:"query"`
+}
+
+type SearchRow struct {
+	EntityType string         `json:"entity_type"`
+	EntityID   string         `json:"entity_id"`
+	Name       string         `json:"name"`
+	Snippet    string         `json:"snippet"`
+	AreaID     sql.NullString `json:"area_id"`
+	ProjectID  sql.NullString `json:"project_id"`
+}
+
+// Search ranks the areas, projects and tasks matching a full-text query
+func (q *Queries) Search(ctx context.Context, arg SearchParams) ([]SearchRow, error) {
+	rows, err := q.db.QueryContext(ctx, search,
+		ftsQuery(arg.Query),
+		arg.EntityTypes,
+		arg.AreaID,
+		arg.TaskStatus,
+		arg.ProjectStatus,
+		arg.IncludeArchived,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchRow{}
+	for rows.Next() {
+		var i SearchRow
+		if err := rows.Scan(
+			&i.EntityType,
+			&i.EntityID,
+			&i.Name,
+			&i.Snippet,
+			&i.AreaID,
+			&i.ProjectID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// ftsQuery turns the words of a search into an FTS5 query that matches every
+// word, with the last also matching longer words it is the start of
+func ftsQuery(words string) string {
+	terms := strings.Fields(words)
+	for i, term := range terms {
+		terms[i] = `"` + term + `"`
+	}
+	if len(terms) > 0 {
+		terms[len(terms)-1] += "*"
+	}
+	return strings.Join(terms, " ")
+}
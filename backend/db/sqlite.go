@@ -1,124 +1,374 @@
 package db
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
-	"io"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// sqliteOptions are the settings OpenSQLite applies to every connection
+type sqliteOptions struct {
+	foreignKeys bool
+	journalMode string
+	synchronous string
+	busyTimeout time.Duration
+}
+
+// SQLiteOption overrides one of the connection settings used by OpenSQLite
+type SQLiteOption func(*sqliteOptions)
+
+// WithForeignKeys turns enforcement of foreign key constraints on or off. It
+// is on by default.
+func WithForeignKeys(enabled bool) SQLiteOption {
+	return func(o *sqliteOptions) {
+		o.foreignKeys = enabled
+	}
+}
+
+// WithJournalMode sets the journal mode, one of DELETE, TRUNCATE, PERSIST,
+// MEMORY, WAL or OFF. The default is WAL, which lets readers carry on while a
+// write is in progress.
+func WithJournalMode(mode string) SQLiteOption {
+	return func(o *sqliteOptions) {
+		o.journalMode = mode
+	}
+}
+
+// WithSynchronous sets how often SQLite waits for writes to reach the disk,
+// one of OFF, NORMAL, FULL or EXTRA. The default is NORMAL, which in WAL mode
+// can lose the last transactions on power loss but never corrupts the
+// database.
+func WithSynchronous(level string) SQLiteOption {
+	return func(o *sqliteOptions) {
+		o.synchronous = level
+	}
+}
+
+// WithBusyTimeout sets how long a connection waits for another one to release
+// its lock before failing with "database is locked". The default is five
+// seconds.
+func WithBusyTimeout(timeout time.Duration) SQLiteOption {
+	return func(o *sqliteOptions) {
+		o.busyTimeout = timeout
+	}
+}
+
 // OpenSQLite opens a SQLite database connection
-func OpenSQLite(dbPath string) (*Store, error) {
-	db, err := sql.Open("sqlite3", dbPath)
+//
+// The settings are passed in the connection string rather than run as PRAGMA
+// statements so that every connection in the pool gets them, not just the
+// first. Transactions take the write lock when they begin, so two of them
+// never both read and then both try to write, which SQLite can only resolve by
+// failing one of them without waiting for the busy timeout.
+func OpenSQLite(dbPath string, opts ...SQLiteOption) (*Store, error) {
+	options := sqliteOptions{
+		foreignKeys: true,
+		journalMode: "WAL",
+		synchronous: "NORMAL",
+		busyTimeout: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	// Backups go in a directory next to the database
+	backupDir := filepath.Join(filepath.Dir(dbPath), "backups")
+	if err := upgradeSQLite(dbPath, options, backupDir); err != nil {
+		return nil, err
+	}
+
+	db, err := sql.Open("sqlite3", sqliteDSN(dbPath, options))
 	if err != nil {
 		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
 	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	store := NewStore(db)
+	store.backupDir = backupDir
+	return store, nil
+}
+
+// sqliteDSN returns the connection string for a database with the given settings
+func sqliteDSN(dbPath string, options sqliteOptions) string {
+	params := url.Values{}
+	params.Set("_foreign_keys", strconv.FormatBool(options.foreignKeys))
+	params.Set("_journal_mode", options.journalMode)
+	params.Set("_synchronous", options.synchronous)
+	params.Set("_busy_timeout", strconv.FormatInt(options.busyTimeout.Milliseconds(), 10))
+	params.Set("_txlock", "immediate")
+	return dbPath + "?" + params.Encode()
+}
+
+// upgradeSQLite checks, backs up and migrates a database before OpenSQLite
+// serves it. This is done on a connection of its own with foreign keys off:
+// databases written before they were enforced can hold rows whose parent was
+// deleted, and migrations that rebuild a table by copying it would fail on
+// them. Those rows are repaired once the schema is current, if foreign keys
+// are to be enforced.
+func upgradeSQLite(dbPath string, options sqliteOptions, backupDir string) error {
+	ctx := context.Background()
+	upgrade := options
+	upgrade.foreignKeys = false
+
+	db, err := sql.Open("sqlite3", sqliteDSN(dbPath, upgrade))
+	if err != nil {
+		return fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+	defer db.Close()
 
 	// Test the connection
 	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	// The migrations create FTS5 tables, which would otherwise fail with "no
+	// such module: fts5"
+	if err := checkFTS5(ctx, db); err != nil {
+		return err
+	}
+
+	// Refuse to run migrations against, or serve, a corrupt database. The quick
+	// check skips comparing indexes with their tables, which would read the
+	// whole database on every start; Backup runs the full check.
+	if err := checkIntegrity(ctx, db, "quick_check"); err != nil {
+		return err
+	}
+
+	// Back up a database that already has a schema before migrating it, so
+	// that a failed or destructive upgrade can be undone
+	version, pending, err := migrationStatus(db, "sqlite3")
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+	if pending && version > 0 {
+		store := NewStore(db)
+		store.backupDir = backupDir
+		if _, err := store.Backup(ctx); err != nil {
+			return fmt.Errorf("failed to back up database before migrating: %w", err)
+		}
 	}
 
 	// Run migrations
 	if err := runMigrations(db, "sqlite3"); err != nil {
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	return NewStore(db), nil
-}
-
-// BackupSQLite creates a backup of the SQLite database file
-func BackupSQLite(dbPath string, maxBackups int) error {
-	// Check if database file exists
-	if _, err := os.Stat(dbPath); os.IsNotExist(err) {
-		// No database file to backup yet
+	if !options.foreignKeys {
 		return nil
 	}
+	repairs, err := repairForeignKeys(ctx, db)
+	if err != nil {
+		return fmt.Errorf("failed to repair foreign keys: %w", err)
+	}
+	for _, repair := range repairs {
+		fmt.Printf("Repaired database before enforcing foreign keys: %s\n", repair)
+	}
+	return nil
+}
 
-	// Create backup directory
-	backupDir := filepath.Join(filepath.Dir(dbPath), "backups")
-	if err := os.MkdirAll(backupDir, 0755); err != nil {
-		return fmt.Errorf("failed to create backup directory: %w", err)
+// ErrFTS5Unavailable is returned by OpenSQLite when the SQLite driver was built
+// without full-text search. go-sqlite3 only includes FTS5 when built with the
+// sqlite_fts5 tag.
+var ErrFTS5Unavailable = errors.New("SQLite was built without FTS5, which search needs; rebuild with -tags sqlite_fts5")
+
+// checkFTS5 returns ErrFTS5Unavailable if SQLite cannot create FTS5 tables
+func checkFTS5(ctx context.Context, db *sql.DB) error {
+	var enabled bool
+	if err := db.QueryRowContext(ctx, "SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled); err != nil {
+		return fmt.Errorf("failed to check for FTS5: %w", err)
 	}
+	if !enabled {
+		return ErrFTS5Unavailable
+	}
+	return nil
+}
 
-	// Create timestamped backup filename
-	timestamp := time.Now().Format("2006-01-02_15-04-05")
-	backupPath := filepath.Join(backupDir, fmt.Sprintf("planner_%s.db", timestamp))
+// ErrIntegrityCheckFailed is returned when SQLite finds the database damaged
+var ErrIntegrityCheckFailed = errors.New("database failed integrity check")
 
-	// Copy database file to backup
-	if err := copyFile(dbPath, backupPath); err != nil {
-		return fmt.Errorf("failed to copy database to backup: %w", err)
+// checkIntegrity runs one of SQLite's integrity checks: integrity_check, which
+// finds damaged pages, indexes that disagree with their tables and rows that
+// break NOT NULL or CHECK constraints, or quick_check, which does the same
+// except for the indexes. Neither checks foreign keys; see repairForeignKeys.
+func checkIntegrity(ctx context.Context, db *sql.DB, check string) error {
+	rows, err := db.QueryContext(ctx, "PRAGMA "+check)
+	if err != nil {
+		return fmt.Errorf("failed to check database integrity: %w", err)
 	}
+	defer rows.Close()
 
-	// Clean up old backups (keep only maxBackups most recent)
-	if err := cleanupOldBackups(backupDir, maxBackups); err != nil {
-		return fmt.Errorf("failed to cleanup old backups: %w", err)
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return fmt.Errorf("failed to check database integrity: %w", err)
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to check database integrity: %w", err)
 	}
 
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrIntegrityCheckFailed, strings.Join(problems, "; "))
+	}
 	return nil
 }
 
-// copyFile copies a file from src to dst
-func copyFile(src, dst string) error {
-	sourceFile, err := os.Open(src)
-	if err != nil {
-		return err
+// Backups are named after the time they were taken, to the millisecond so
+// that two taken in quick succession do not collide
+const (
+	backupPrefix     = "planner_"
+	backupExt        = ".db"
+	backupTimeFormat = "2006-01-02_15-04-05.000"
+)
+
+// ErrBackupsUnsupported is returned by the backup methods of a Store whose
+// database is not backed up by the server. PostgreSQL databases are backed up
+// with PostgreSQL's own tools.
+var ErrBackupsUnsupported = errors.New("backups are only supported for SQLite databases")
+
+// BackupInfo describes a backup of the database
+type BackupInfo struct {
+	Name      string
+	Path      string
+	Size      int64
+	CreatedAt time.Time
+}
+
+// Backup writes a copy of the database to a new file in the backup directory.
+// VACUUM INTO reads the whole database in one transaction, so the copy is
+// consistent even while other connections are writing, and unlike copying
+// the file it includes changes still in the write-ahead log. The database
+// gets a full integrity check first, and a damaged one is not backed up so
+// that it never displaces a good backup when old ones are pruned.
+func (s *Store) Backup(ctx context.Context) (BackupInfo, error) {
+	if s.backupDir == "" {
+		return BackupInfo{}, ErrBackupsUnsupported
 	}
-	defer sourceFile.Close()
 
-	destFile, err := os.Create(dst)
-	if err != nil {
-		return err
+	if err := checkIntegrity(ctx, s.db, "integrity_check"); err != nil {
+		return BackupInfo{}, err
 	}
-	defer destFile.Close()
 
-	if _, err := io.Copy(destFile, sourceFile); err != nil {
-		return err
+	if err := os.MkdirAll(s.backupDir, 0755); err != nil {
+		return BackupInfo{}, fmt.Errorf("failed to create backup directory: %w", err)
 	}
 
-	return destFile.Sync()
+	name := backupPrefix + time.Now().Format(backupTimeFormat) + backupExt
+	path := filepath.Join(s.backupDir, name)
+
+	// Write to a temporary file and rename it into place, so that a backup
+	// that fails halfway is never listed
+	tmpPath := path + ".tmp"
+	if _, err := s.db.ExecContext(ctx, "VACUUM INTO ?", tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return BackupInfo{}, fmt.Errorf("failed to back up database: %w", err)
+	}
+	if err := syncFile(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return BackupInfo{}, fmt.Errorf("failed to sync backup: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return BackupInfo{}, fmt.Errorf("failed to save backup: %w", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return BackupInfo{}, fmt.Errorf("failed to stat backup: %w", err)
+	}
+	return backupInfo(path, info), nil
 }
 
-// cleanupOldBackups removes old backup files, keeping only the most recent maxBackups
-func cleanupOldBackups(backupDir string, maxBackups int) error {
-	files, err := os.ReadDir(backupDir)
+// ListBackups lists the backups in the backup directory, most recent first
+func (s *Store) ListBackups() ([]BackupInfo, error) {
+	if s.backupDir == "" {
+		return nil, ErrBackupsUnsupported
+	}
+
+	files, err := os.ReadDir(s.backupDir)
+	if os.IsNotExist(err) {
+		// No backup has been taken yet
+		return nil, nil
+	}
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
 	}
 
-	// Filter for .db files
-	var backupFiles []os.DirEntry
+	var backups []BackupInfo
 	for _, file := range files {
-		if !file.IsDir() && filepath.Ext(file.Name()) == ".db" {
-			backupFiles = append(backupFiles, file)
+		name := file.Name()
+		if file.IsDir() || !strings.HasPrefix(name, backupPrefix) || filepath.Ext(name) != backupExt {
+			continue
+		}
+		info, err := file.Info()
+		if os.IsNotExist(err) {
+			// Removed since the directory was read
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat backup %s: %w", name, err)
 		}
+		backups = append(backups, backupInfo(filepath.Join(s.backupDir, name), info))
 	}
 
-	// If we have fewer backups than the max, nothing to clean up
-	if len(backupFiles) <= maxBackups {
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// PruneBackups removes all but the keep most recent backups
+func (s *Store) PruneBackups(keep int) error {
+	backups, err := s.ListBackups()
+	if err != nil {
+		return err
+	}
+	if len(backups) <= keep {
 		return nil
 	}
 
-	// Sort by modification time (oldest first)
-	sort.Slice(backupFiles, func(i, j int) bool {
-		infoI, _ := backupFiles[i].Info()
-		infoJ, _ := backupFiles[j].Info()
-		return infoI.ModTime().Before(infoJ.ModTime())
-	})
-
-	// Remove oldest backups
-	toRemove := len(backupFiles) - maxBackups
-	for i := 0; i < toRemove; i++ {
-		backupPath := filepath.Join(backupDir, backupFiles[i].Name())
-		if err := os.Remove(backupPath); err != nil {
-			return fmt.Errorf("failed to remove old backup %s: %w", backupFiles[i].Name(), err)
+	for _, backup := range backups[keep:] {
+		if err := os.Remove(backup.Path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove old backup %s: %w", backup.Name, err)
 		}
 	}
-
 	return nil
 }
+
+// backupInfo describes the backup at path
+func backupInfo(path string, info os.FileInfo) BackupInfo {
+	return BackupInfo{
+		Name:      info.Name(),
+		Path:      path,
+		Size:      info.Size(),
+		CreatedAt: info.ModTime(),
+	}
+}
+
+// syncFile flushes a file that was written by someone else to disk
+func syncFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return file.Sync()
+}
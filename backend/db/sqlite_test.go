@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/pressly/goose/v3"
+)
+
+// OpenSQLite snapshots a database that has pending migrations before applying
+// them, and leaves new and up-to-date databases alone
+func TestOpenSQLiteBacksUpBeforeMigrating(t *testing.T) {
+	dir := t.TempDir()
+	dbPath := filepath.Join(dir, "planner.db")
+
+	// Migrate part of the way, as an older release would have
+	const oldVersion = 14
+	old, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	migrationsDir, err := setupMigrations("sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := goose.UpTo(old, migrationsDir, oldVersion); err != nil {
+		t.Fatalf("failed to migrate to version %d: %v", oldVersion, err)
+	}
+	if _, err := old.Exec("INSERT INTO areas (id, name) VALUES ('a1', 'kept')"); err != nil {
+		t.Fatalf("failed to insert area: %v", err)
+	}
+	old.Close()
+
+	store, err := OpenSQLite(dbPath)
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	backups, err := store.ListBackups()
+	if err != nil {
+		t.Fatalf("ListBackups: %v", err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("backups after upgrade = %d, want 1", len(backups))
+	}
+
+	snapshot, err := sql.Open("sqlite3", backups[0].Path)
+	if err != nil {
+		t.Fatalf("failed to open backup: %v", err)
+	}
+	defer snapshot.Close()
+	version, err := goose.GetDBVersion(snapshot)
+	if err != nil {
+		t.Fatalf("failed to read backup version: %v", err)
+	}
+	if version != oldVersion {
+		t.Errorf("backup version = %d, want %d", version, oldVersion)
+	}
+	var name string
+	if err := snapshot.QueryRow("SELECT name FROM areas WHERE id = 'a1'").Scan(&name); err != nil {
+		t.Errorf("backup is missing the area: %v", err)
+	}
+
+	// Reopening the migrated database takes no further backup
+	store.Close()
+	store, err = OpenSQLite(dbPath)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer store.Close()
+	if backups, err := store.ListBackups(); err != nil || len(backups) != 1 {
+		t.Errorf("backups after reopening = %d (%v), want 1", len(backups), err)
+	}
+}
+
+// A database written before foreign keys were enforced can hold rows whose
+// parent was deleted. OpenSQLite upgrades it anyway and repairs them.
+func TestOpenSQLiteRepairsOrphansFromBeforeForeignKeys(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "planner.db")
+
+	// Migrate to the first release's schema and delete parents out from under
+	// their rows, which its connections, without foreign keys, allowed
+	old, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	migrationsDir, err := setupMigrations("sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := goose.UpTo(old, migrationsDir, 1); err != nil {
+		t.Fatalf("failed to migrate to version 1: %v", err)
+	}
+	for _, stmt := range []string{
+		"INSERT INTO areas (id, name) VALUES ('a1', 'Home')",
+		"INSERT INTO projects (id, name, area_id) VALUES ('p1', 'Garden', 'a1')",
+		"INSERT INTO projects (id, name, area_id) VALUES ('p2', 'Orphan', 'gone')",
+		"INSERT INTO tasks (id, name, project_id) VALUES ('t1', 'Kept', 'p1')",
+		"INSERT INTO tasks (id, name, project_id) VALUES ('t2', 'Orphan', 'gone')",
+	} {
+		if _, err := old.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	old.Close()
+
+	store, err := OpenSQLite(dbPath)
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	defer store.Close()
+	ctx := context.Background()
+	q := New(store.db)
+
+	if task, err := q.GetTask(ctx, "t1"); err != nil || task.ProjectID.String != "p1" {
+		t.Errorf("task t1 project = %q (%v), want p1", task.ProjectID.String, err)
+	}
+	if task, err := q.GetTask(ctx, "t2"); err != nil || task.ProjectID.Valid {
+		t.Errorf("task t2 project = %v (%v), want the Inbox", task.ProjectID, err)
+	}
+	project, err := q.GetProject(ctx, "p2")
+	if err != nil {
+		t.Fatalf("GetProject p2: %v", err)
+	}
+	area, err := q.GetArea(ctx, project.AreaID)
+	if err != nil {
+		t.Fatalf("area of p2: %v", err)
+	}
+	if area.Name != recoveredAreaName {
+		t.Errorf("area of p2 = %q, want %q", area.Name, recoveredAreaName)
+	}
+
+	violations, err := foreignKeyCheck(ctx, store.db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 0 {
+		t.Errorf("foreign key violations after upgrade = %v, want none", violations)
+	}
+}
+
+func TestOpenSQLiteNewDatabaseNotBackedUp(t *testing.T) {
+	store, err := OpenSQLite(filepath.Join(t.TempDir(), "planner.db"))
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	defer store.Close()
+
+	backups, err := store.ListBackups()
+	if err != nil {
+		t.Fatalf("ListBackups: %v", err)
+	}
+	if len(backups) != 0 {
+		t.Errorf("backups of a new database = %d, want 0", len(backups))
+	}
+}
+
+// A damaged index passes the quick check on open but stops a backup
+func TestBackupRefusesDamagedDatabase(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "planner.db")
+	store, err := OpenSQLite(dbPath)
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	store.Close()
+
+	// Point an index at a different column than the one it was built from
+	damaged, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	damaged.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		"CREATE TABLE damaged (a TEXT, b TEXT)",
+		"CREATE INDEX idx_damaged ON damaged(a)",
+		"INSERT INTO damaged VALUES ('x', 'y')",
+		"PRAGMA writable_schema = ON",
+		"UPDATE sqlite_master SET sql = 'CREATE INDEX idx_damaged ON damaged(b)' WHERE name = 'idx_damaged'",
+		"PRAGMA writable_schema = OFF",
+	} {
+		if _, err := damaged.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+	damaged.Close()
+
+	store, err = OpenSQLite(dbPath)
+	if err != nil {
+		t.Fatalf("OpenSQLite with a damaged index: %v", err)
+	}
+	defer store.Close()
+
+	if _, err := store.Backup(context.Background()); !errors.Is(err, ErrIntegrityCheckFailed) {
+		t.Errorf("Backup = %v, want ErrIntegrityCheckFailed", err)
+	}
+	if backups, err := store.ListBackups(); err != nil || len(backups) != 0 {
+		t.Errorf("backups of a damaged database = %d (%v), want 0", len(backups), err)
+	}
+}
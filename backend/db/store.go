@@ -1,46 +1,264 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
 	"github.com/pressly/goose/v3"
 )
 
-//go:embed migrations/*.sql
+// A transaction that loses a race with another writer is retried up to
+// maxTxAttempts times in all, waiting txRetryDelay before the first retry and
+// twice as long before each one after
+const (
+	maxTxAttempts = 5
+	txRetryDelay  = 10 * time.Millisecond
+)
+
+// Each dialect has its own migrations, numbered in step so a schema version
+// means the same thing on both
+//
+//go:embed migrations/sqlite/*.sql migrations/postgres/*.sql
 var embedMigrations embed.FS
 
 // Store provides database operations
 type Store struct {
 	db      *sql.DB
-	Queries *Queries
+	Queries Querier
+
+	// newQueries binds the dialect's queries to a connection or transaction
+	newQueries func(DBTX) Querier
+
+	// txOptions are used for every transaction started by WithTx
+	txOptions *sql.TxOptions
+
+	// backupDir is where Backup writes backups; empty if the database is not
+	// backed up by the server
+	backupDir string
 }
 
-// NewStore creates a new database store
+// NewStore creates a new database store for a SQLite database
 func NewStore(db *sql.DB) *Store {
+	return newStore(db, func(conn DBTX) Querier {
+		return New(conn)
+	})
+}
+
+// newStore creates a new database store whose queries are made by newQueries
+func newStore(db *sql.DB, newQueries func(DBTX) Querier) *Store {
 	return &Store{
-		db:      db,
-		Queries: New(db),
+		db:         db,
+		Queries:    newQueries(db),
+		newQueries: newQueries,
+	}
+}
+
+// WithTx runs fn in a database transaction. The transaction is committed if fn
+// returns nil and rolled back otherwise; fn's error is returned unchanged.
+//
+// If the transaction fails because another writer got in first (SQLITE_BUSY
+// on SQLite, a serialization failure or deadlock on PostgreSQL) it is rolled
+// back and fn is run again from the start, so fn must not have effects outside
+// the transaction that cannot be repeated.
+func (s *Store) WithTx(ctx context.Context, fn func(Querier) error) error {
+	delay := txRetryDelay
+	for attempt := 1; ; attempt++ {
+		retryable, err := s.runTx(ctx, fn)
+		if err == nil || !retryable || attempt == maxTxAttempts {
+			return err
+		}
+
+		// Jitter keeps transactions that collided from colliding again
+		wait := delay/2 + rand.N(delay)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		delay *= 2
+	}
+}
+
+// runTx makes a single attempt at the transaction for WithTx, reporting
+// whether a failure is worth retrying
+func (s *Store) runTx(ctx context.Context, fn func(Querier) error) (bool, error) {
+	tx, err := s.db.BeginTx(ctx, s.txOptions)
+	if err != nil {
+		return isRetryable(err), fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	conn := &retryTracker{DBTX: tx}
+	if err := fn(txQueries{Querier: s.newQueries(conn), conn: conn}); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return false, fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return conn.retryable || isRetryable(err), err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return isRetryable(err), fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return false, nil
+}
+
+// txQueries is the Querier that WithTx passes to its function. It keeps the
+// transaction's connection so that Savepoint can reach it.
+type txQueries struct {
+	Querier
+	conn DBTX
+}
+
+// Savepoint runs fn, which must only use q, so that if it fails the changes it
+// made are undone while the rest of the transaction carries on. Without this a
+// failed statement aborts the whole transaction on PostgreSQL. q must be the
+// Querier that WithTx passed to its function; fn's error is returned unchanged.
+func Savepoint(ctx context.Context, q Querier, fn func() error) error {
+	tx, ok := q.(txQueries)
+	if !ok {
+		return errors.New("savepoint outside of a transaction")
+	}
+
+	if _, err := tx.conn.ExecContext(ctx, "SAVEPOINT item"); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+	if err := fn(); err != nil {
+		if _, rbErr := tx.conn.ExecContext(ctx, "ROLLBACK TO SAVEPOINT item"); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+		}
+		if _, relErr := tx.conn.ExecContext(ctx, "RELEASE SAVEPOINT item"); relErr != nil {
+			return fmt.Errorf("%w (release savepoint failed: %v)", err, relErr)
+		}
+		return err
+	}
+	if _, err := tx.conn.ExecContext(ctx, "RELEASE SAVEPOINT item"); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
+// retryTracker notes whether any statement in a transaction failed in a way
+// that retrying the transaction could fix. fn usually wraps the errors it sees
+// into ones that no longer say why, so they are checked here as they happen.
+type retryTracker struct {
+	DBTX
+	retryable bool
+}
+
+func (t *retryTracker) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	result, err := t.DBTX.ExecContext(ctx, query, args...)
+	t.note(err)
+	return result, err
+}
+
+func (t *retryTracker) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	stmt, err := t.DBTX.PrepareContext(ctx, query)
+	t.note(err)
+	return stmt, err
+}
+
+func (t *retryTracker) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	rows, err := t.DBTX.QueryContext(ctx, query, args...)
+	t.note(err)
+	return rows, err
+}
+
+func (t *retryTracker) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	row := t.DBTX.QueryRowContext(ctx, query, args...)
+	t.note(row.Err())
+	return row
+}
+
+// note records err if retrying could fix it
+func (t *retryTracker) note(err error) {
+	if isRetryable(err) {
+		t.retryable = true
 	}
 }
 
+// isRetryable reports whether err means the transaction lost a race with
+// another one and would likely succeed if run again
+func isRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.Code == sqlite3.ErrBusy || sqliteErr.Code == sqlite3.ErrLocked
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01": // serialization_failure, deadlock_detected
+			return true
+		}
+	}
+
+	return false
+}
+
 // Close closes the database connection
 func (s *Store) Close() error {
 	return s.db.Close()
 }
 
-// runMigrations runs database migrations
+// runMigrations runs the database migrations of the given goose dialect
 func runMigrations(db *sql.DB, dialect string) error {
+	dir, err := setupMigrations(dialect)
+	if err != nil {
+		return err
+	}
+	if err := goose.Up(db, dir); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	return nil
+}
+
+// migrationStatus returns the schema version of the database, zero if it has
+// never been migrated, and whether any migrations are still to be applied
+func migrationStatus(db *sql.DB, dialect string) (int64, bool, error) {
+	dir, err := setupMigrations(dialect)
+	if err != nil {
+		return 0, false, err
+	}
+
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	migrations, err := goose.CollectMigrations(dir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+	latest, err := migrations.Last()
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to find latest migration: %w", err)
+	}
+
+	return current, current < latest.Version, nil
+}
+
+// setupMigrations points goose at the embedded migrations of the given
+// dialect and returns their directory
+func setupMigrations(dialect string) (string, error) {
 	goose.SetBaseFS(embedMigrations)
 
 	if err := goose.SetDialect(dialect); err != nil {
-		return fmt.Errorf("failed to set dialect: %w", err)
+		return "", fmt.Errorf("failed to set dialect: %w", err)
 	}
 
-	if err := goose.Up(db, "migrations"); err != nil {
-		return fmt.Errorf("failed to apply migrations: %w", err)
+	if dialect == "postgres" {
+		return "migrations/postgres", nil
 	}
-
-	return nil
+	return "migrations/sqlite", nil
 }
@@ -0,0 +1,194 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "other error", err: errors.New("boom"), want: false},
+		{name: "sqlite busy", err: sqlite3.Error{Code: sqlite3.ErrBusy}, want: true},
+		{name: "sqlite locked", err: sqlite3.Error{Code: sqlite3.ErrLocked}, want: true},
+		{name: "sqlite constraint", err: sqlite3.Error{Code: sqlite3.ErrConstraint}, want: false},
+		{name: "wrapped sqlite busy", err: fmt.Errorf("failed to begin transaction: %w", sqlite3.Error{Code: sqlite3.ErrBusy}), want: true},
+		{name: "postgres serialization failure", err: &pq.Error{Code: "40001"}, want: true},
+		{name: "postgres deadlock", err: &pq.Error{Code: "40P01"}, want: true},
+		{name: "postgres unique violation", err: &pq.Error{Code: "23505"}, want: false},
+		{name: "wrapped postgres serialization failure", err: fmt.Errorf("failed to commit transaction: %w", &pq.Error{Code: "40001"}), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.err); got != tt.want {
+				t.Errorf("isRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithTxRetries(t *testing.T) {
+	busy := sqlite3.Error{Code: sqlite3.ErrBusy}
+	serialization := &pq.Error{Code: "40001"}
+	other := errors.New("boom")
+
+	tests := []struct {
+		name string
+		// errs are returned by successive attempts; later attempts succeed
+		errs         []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "success", wantAttempts: 1},
+		{name: "busy once", errs: []error{busy}, wantAttempts: 2},
+		{name: "serialization failures", errs: []error{serialization, serialization}, wantAttempts: 3},
+		{name: "other error", errs: []error{other}, wantErr: other, wantAttempts: 1},
+		{name: "busy then other error", errs: []error{busy, other}, wantErr: other, wantAttempts: 2},
+		{
+			name:         "always busy",
+			errs:         []error{busy, busy, busy, busy, busy, busy},
+			wantErr:      busy,
+			wantAttempts: maxTxAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := OpenSQLite(filepath.Join(t.TempDir(), "planner.db"))
+			if err != nil {
+				t.Fatalf("OpenSQLite: %v", err)
+			}
+			defer store.Close()
+
+			attempts := 0
+			err = store.WithTx(context.Background(), func(q Querier) error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("WithTx = %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+// A transaction that cannot take SQLite's write lock is retried until the
+// writer holding it commits
+func TestWithTxRetriesWhileSQLiteLocked(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "planner.db")
+	store, err := OpenSQLite(dbPath, WithBusyTimeout(0))
+	if err != nil {
+		t.Fatalf("OpenSQLite: %v", err)
+	}
+	defer store.Close()
+
+	other, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer other.Close()
+	conn, err := other.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(context.Background(), "BEGIN IMMEDIATE"); err != nil {
+		t.Fatalf("failed to take write lock: %v", err)
+	}
+	// Without retrying, the transaction cannot start
+	if _, err := store.runTx(context.Background(), func(Querier) error { return nil }); !isRetryable(err) {
+		t.Fatalf("transaction while locked = %v, want SQLITE_BUSY", err)
+	}
+
+	time.AfterFunc(30*time.Millisecond, func() {
+		conn.ExecContext(context.Background(), "COMMIT")
+	})
+
+	err = store.WithTx(context.Background(), func(q Querier) error {
+		now := time.Now()
+		_, err := q.CreateArea(context.Background(), CreateAreaParams{
+			ID:        uuid.New().String(),
+			Name:      "after the lock",
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		return err
+	})
+	if err != nil {
+		t.Errorf("WithTx = %v, want it to succeed once the lock is released", err)
+	}
+}
+
+// A serializable transaction that loses a write conflict on PostgreSQL is run
+// again once the winner commits
+func TestPostgresWithTxRetriesSerializationFailure(t *testing.T) {
+	store := openTestPostgres(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	area, err := store.Queries.CreateArea(ctx, CreateAreaParams{
+		ID:        uuid.New().String(),
+		Name:      "contended",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("failed to create area: %v", err)
+	}
+
+	setSortOrder := func(q Querier, sortOrder float64) error {
+		return q.SetAreaSortOrder(ctx, SetAreaSortOrderParams{ID: area.ID, SortOrder: sortOrder, UpdatedAt: time.Now()})
+	}
+
+	// The first transaction updates the area and stays open, so the second
+	// waits on its row lock and then fails to serialize when it commits
+	first, err := store.db.BeginTx(ctx, store.txOptions)
+	if err != nil {
+		t.Fatalf("failed to begin first transaction: %v", err)
+	}
+	defer first.Rollback()
+	if err := setSortOrder(store.newQueries(first), 1); err != nil {
+		t.Fatalf("failed to update area in first transaction: %v", err)
+	}
+
+	attempts := 0
+	done := make(chan error, 1)
+	go func() {
+		done <- store.WithTx(ctx, func(q Querier) error {
+			attempts++
+			return setSortOrder(q, 2)
+		})
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	if err := first.Commit(); err != nil {
+		t.Fatalf("failed to commit first transaction: %v", err)
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("WithTx: %v", err)
+	}
+	if attempts < 2 {
+		t.Errorf("attempts = %d, want a retry after the serialization failure", attempts)
+	}
+}
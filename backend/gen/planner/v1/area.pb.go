@@ -35,7 +35,12 @@ type Area struct {
 	// Timestamp when the area was created
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the area was last updated
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Timestamp when the area was archived (unset unless archived)
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// Version of the area, increased by every update; pass it back as expected_version
+	// to update or delete the area only if nobody else has changed it since
+	Version       int64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +110,20 @@ func (x *Area) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Area) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Area) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Request to create a new area
 type CreateAreaRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -301,12 +320,17 @@ func (x *GetAreaResponse) GetArea() *Area {
 // Request to list all areas
 type ListAreasRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional pagination limit (future use)
+	// Maximum number of items to return (defaults to 50, capped at 500)
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional pagination token (future use)
-	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Token from a previous response's next_page_token to continue listing; the
+	// request's other fields, apart from page_size, must be the same as before
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// How to sort the areas (defaults to the manual order)
+	Order ListOrder `protobuf:"varint,3,opt,name=order,proto3,enum=planner.v1.ListOrder" json:"order,omitempty"`
+	// Include archived areas
+	IncludeArchived bool `protobuf:"varint,4,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListAreasRequest) Reset() {
@@ -353,12 +377,26 @@ func (x *ListAreasRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListAreasRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *ListAreasRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 // Response containing a list of areas
 type ListAreasResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of areas
 	Areas []*Area `protobuf:"bytes,1,rep,name=areas,proto3" json:"areas,omitempty"`
-	// Token for next page (future use)
+	// Token for the next page, empty when there are no more results
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -416,9 +454,12 @@ type UpdateAreaRequest struct {
 	// New name (if provided)
 	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	// New description (if provided)
-	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Only update the area if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateAreaRequest) Reset() {
@@ -472,6 +513,13 @@ func (x *UpdateAreaRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateAreaRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 // Response containing the updated area
 type UpdateAreaResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -522,9 +570,16 @@ func (x *UpdateAreaResponse) GetArea() *Area {
 type DeleteAreaRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// ID of the area to delete
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What to do with the area's projects and tasks (defaults to restrict)
+	Mode DeleteMode `protobuf:"varint,2,opt,name=mode,proto3,enum=planner.v1.DeleteMode" json:"mode,omitempty"`
+	// Area to move the projects to when mode is DELETE_MODE_REASSIGN
+	ReassignAreaId string `protobuf:"bytes,3,opt,name=reassign_area_id,json=reassignAreaId,proto3" json:"reassign_area_id,omitempty"`
+	// Only delete the area if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteAreaRequest) Reset() {
@@ -564,6 +619,27 @@ func (x *DeleteAreaRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteAreaRequest) GetMode() DeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DeleteMode_DELETE_MODE_UNSPECIFIED
+}
+
+func (x *DeleteAreaRequest) GetReassignAreaId() string {
+	if x != nil {
+		return x.ReassignAreaId
+	}
+	return ""
+}
+
+func (x *DeleteAreaRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 // Response confirming deletion
 type DeleteAreaResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -610,12 +686,306 @@ func (x *DeleteAreaResponse) GetSuccess() bool {
 	return false
 }
 
+// Request to move an area to a new position in the manual order
+type ReorderAreaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the area to move
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the area it should come directly after (empty if only before_id is given)
+	AfterId string `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// ID of the area it should come directly before (empty if only after_id is given)
+	BeforeId      string `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderAreaRequest) Reset() {
+	*x = ReorderAreaRequest{}
+	mi := &file_planner_v1_area_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderAreaRequest) ProtoMessage() {}
+
+func (x *ReorderAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderAreaRequest.ProtoReflect.Descriptor instead.
+func (*ReorderAreaRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReorderAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderAreaRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *ReorderAreaRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+// Response containing the moved area
+type ReorderAreaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The moved area
+	Area          *Area `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderAreaResponse) Reset() {
+	*x = ReorderAreaResponse{}
+	mi := &file_planner_v1_area_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderAreaResponse) ProtoMessage() {}
+
+func (x *ReorderAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderAreaResponse.ProtoReflect.Descriptor instead.
+func (*ReorderAreaResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReorderAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+// Request to archive an area
+type ArchiveAreaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the area to archive
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAreaRequest) Reset() {
+	*x = ArchiveAreaRequest{}
+	mi := &file_planner_v1_area_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAreaRequest) ProtoMessage() {}
+
+func (x *ArchiveAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAreaRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAreaRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ArchiveAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the archived area
+type ArchiveAreaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The archived area
+	Area          *Area `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAreaResponse) Reset() {
+	*x = ArchiveAreaResponse{}
+	mi := &file_planner_v1_area_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAreaResponse) ProtoMessage() {}
+
+func (x *ArchiveAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAreaResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAreaResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ArchiveAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
+// Request to unarchive an area
+type UnarchiveAreaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the area to unarchive
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveAreaRequest) Reset() {
+	*x = UnarchiveAreaRequest{}
+	mi := &file_planner_v1_area_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveAreaRequest) ProtoMessage() {}
+
+func (x *UnarchiveAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveAreaRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveAreaRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnarchiveAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the unarchived area
+type UnarchiveAreaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The unarchived area
+	Area          *Area `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveAreaResponse) Reset() {
+	*x = UnarchiveAreaResponse{}
+	mi := &file_planner_v1_area_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveAreaResponse) ProtoMessage() {}
+
+func (x *UnarchiveAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_area_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveAreaResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveAreaResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_area_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UnarchiveAreaResponse) GetArea() *Area {
+	if x != nil {
+		return x.Area
+	}
+	return nil
+}
+
 var File_planner_v1_area_proto protoreflect.FileDescriptor
 
 const file_planner_v1_area_proto_rawDesc = "" +
 	"\n" +
 	"\x15planner/v1/area.proto\x12\n" +
-	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xc2\x01\n" +
+	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17planner/v1/common.proto\"\x99\x02\n" +
 	"\x04Area\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -623,7 +993,10 @@ const file_planner_v1_area_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\varchived_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\"_\n" +
 	"\x11CreateAreaRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
@@ -633,27 +1006,53 @@ const file_planner_v1_area_proto_rawDesc = "" +
 	"\x0eGetAreaRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"7\n" +
 	"\x0fGetAreaResponse\x12$\n" +
-	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\"N\n" +
-	"\x10ListAreasRequest\x12\x1b\n" +
-	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\"\xb9\x01\n" +
+	"\x10ListAreasRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"c\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x125\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x15.planner.v1.ListOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05order\x12)\n" +
+	"\x10include_archived\x18\x04 \x01(\bR\x0fincludeArchived\"c\n" +
 	"\x11ListAreasResponse\x12&\n" +
 	"\x05areas\x18\x01 \x03(\v2\x10.planner.v1.AreaR\x05areas\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9c\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xea\x01\n" +
 	"\x11UpdateAreaRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x02R\x0fexpectedVersion\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
-	"\f_description\":\n" +
+	"\f_descriptionB\x13\n" +
+	"\x11_expected_version\":\n" +
 	"\x12UpdateAreaResponse\x12$\n" +
-	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\"-\n" +
+	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\"\xdd\x03\n" +
 	"\x11DeleteAreaRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\".\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.planner.v1.DeleteModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x125\n" +
+	"\x10reassign_area_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x0ereassignAreaId\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x0fexpectedVersion\x88\x01\x01:\xf2\x01\xbaH\xee\x01\x1a\x89\x01\n" +
+	"\x18reassign_requires_target\x12>reassign_area_id is required when mode is DELETE_MODE_REASSIGN\x1a-this.mode != 3 || this.reassign_area_id != ''\x1a`\n" +
+	"\x16reassign_to_other_area\x12$reassign_area_id must differ from id\x1a this.reassign_area_id != this.idB\x13\n" +
+	"\x11_expected_version\".\n" +
 	"\x12DeleteAreaResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x82\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf7\x02\n" +
+	"\x12ReorderAreaRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\bafter_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aafterId\x12(\n" +
+	"\tbefore_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bbeforeId:\xf4\x01\xbaH\xf0\x01\x1au\n" +
+	"\x12neighbour_required\x122at least one of after_id and before_id is required\x1a+this.after_id != '' || this.before_id != ''\x1aw\n" +
+	"\x12neighbour_not_self\x12*after_id and before_id must differ from id\x1a5this.after_id != this.id && this.before_id != this.id\";\n" +
+	"\x13ReorderAreaResponse\x12$\n" +
+	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\".\n" +
+	"\x12ArchiveAreaRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\";\n" +
+	"\x13ArchiveAreaResponse\x12$\n" +
+	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area\"0\n" +
+	"\x14UnarchiveAreaRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"=\n" +
+	"\x15UnarchiveAreaResponse\x12$\n" +
+	"\x04area\x18\x01 \x01(\v2\x10.planner.v1.AreaR\x04area2\xf8\x04\n" +
 	"\vAreaService\x12K\n" +
 	"\n" +
 	"CreateArea\x12\x1d.planner.v1.CreateAreaRequest\x1a\x1e.planner.v1.CreateAreaResponse\x12B\n" +
@@ -662,7 +1061,10 @@ const file_planner_v1_area_proto_rawDesc = "" +
 	"\n" +
 	"UpdateArea\x12\x1d.planner.v1.UpdateAreaRequest\x1a\x1e.planner.v1.UpdateAreaResponse\x12K\n" +
 	"\n" +
-	"DeleteArea\x12\x1d.planner.v1.DeleteAreaRequest\x1a\x1e.planner.v1.DeleteAreaResponseB\xa4\x01\n" +
+	"DeleteArea\x12\x1d.planner.v1.DeleteAreaRequest\x1a\x1e.planner.v1.DeleteAreaResponse\x12N\n" +
+	"\vReorderArea\x12\x1e.planner.v1.ReorderAreaRequest\x1a\x1f.planner.v1.ReorderAreaResponse\x12N\n" +
+	"\vArchiveArea\x12\x1e.planner.v1.ArchiveAreaRequest\x1a\x1f.planner.v1.ArchiveAreaResponse\x12T\n" +
+	"\rUnarchiveArea\x12 .planner.v1.UnarchiveAreaRequest\x1a!.planner.v1.UnarchiveAreaResponseB\xa4\x01\n" +
 	"\x0ecom.planner.v1B\tAreaProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Planner.V1\xca\x02\n" +
 	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
@@ -679,7 +1081,7 @@ func file_planner_v1_area_proto_rawDescGZIP() []byte {
 	return file_planner_v1_area_proto_rawDescData
 }
 
-var file_planner_v1_area_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_planner_v1_area_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_planner_v1_area_proto_goTypes = []any{
 	(*Area)(nil),                  // 0: planner.v1.Area
 	(*CreateAreaRequest)(nil),     // 1: planner.v1.CreateAreaRequest
@@ -692,30 +1094,50 @@ var file_planner_v1_area_proto_goTypes = []any{
 	(*UpdateAreaResponse)(nil),    // 8: planner.v1.UpdateAreaResponse
 	(*DeleteAreaRequest)(nil),     // 9: planner.v1.DeleteAreaRequest
 	(*DeleteAreaResponse)(nil),    // 10: planner.v1.DeleteAreaResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*ReorderAreaRequest)(nil),    // 11: planner.v1.ReorderAreaRequest
+	(*ReorderAreaResponse)(nil),   // 12: planner.v1.ReorderAreaResponse
+	(*ArchiveAreaRequest)(nil),    // 13: planner.v1.ArchiveAreaRequest
+	(*ArchiveAreaResponse)(nil),   // 14: planner.v1.ArchiveAreaResponse
+	(*UnarchiveAreaRequest)(nil),  // 15: planner.v1.UnarchiveAreaRequest
+	(*UnarchiveAreaResponse)(nil), // 16: planner.v1.UnarchiveAreaResponse
+	(*timestamppb.Timestamp)(nil), // 17: google.protobuf.Timestamp
+	(ListOrder)(0),                // 18: planner.v1.ListOrder
+	(DeleteMode)(0),               // 19: planner.v1.DeleteMode
 }
 var file_planner_v1_area_proto_depIdxs = []int32{
-	11, // 0: planner.v1.Area.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: planner.v1.Area.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: planner.v1.CreateAreaResponse.area:type_name -> planner.v1.Area
-	0,  // 3: planner.v1.GetAreaResponse.area:type_name -> planner.v1.Area
-	0,  // 4: planner.v1.ListAreasResponse.areas:type_name -> planner.v1.Area
-	0,  // 5: planner.v1.UpdateAreaResponse.area:type_name -> planner.v1.Area
-	1,  // 6: planner.v1.AreaService.CreateArea:input_type -> planner.v1.CreateAreaRequest
-	3,  // 7: planner.v1.AreaService.GetArea:input_type -> planner.v1.GetAreaRequest
-	5,  // 8: planner.v1.AreaService.ListAreas:input_type -> planner.v1.ListAreasRequest
-	7,  // 9: planner.v1.AreaService.UpdateArea:input_type -> planner.v1.UpdateAreaRequest
-	9,  // 10: planner.v1.AreaService.DeleteArea:input_type -> planner.v1.DeleteAreaRequest
-	2,  // 11: planner.v1.AreaService.CreateArea:output_type -> planner.v1.CreateAreaResponse
-	4,  // 12: planner.v1.AreaService.GetArea:output_type -> planner.v1.GetAreaResponse
-	6,  // 13: planner.v1.AreaService.ListAreas:output_type -> planner.v1.ListAreasResponse
-	8,  // 14: planner.v1.AreaService.UpdateArea:output_type -> planner.v1.UpdateAreaResponse
-	10, // 15: planner.v1.AreaService.DeleteArea:output_type -> planner.v1.DeleteAreaResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	17, // 0: planner.v1.Area.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: planner.v1.Area.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: planner.v1.Area.archived_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: planner.v1.CreateAreaResponse.area:type_name -> planner.v1.Area
+	0,  // 4: planner.v1.GetAreaResponse.area:type_name -> planner.v1.Area
+	18, // 5: planner.v1.ListAreasRequest.order:type_name -> planner.v1.ListOrder
+	0,  // 6: planner.v1.ListAreasResponse.areas:type_name -> planner.v1.Area
+	0,  // 7: planner.v1.UpdateAreaResponse.area:type_name -> planner.v1.Area
+	19, // 8: planner.v1.DeleteAreaRequest.mode:type_name -> planner.v1.DeleteMode
+	0,  // 9: planner.v1.ReorderAreaResponse.area:type_name -> planner.v1.Area
+	0,  // 10: planner.v1.ArchiveAreaResponse.area:type_name -> planner.v1.Area
+	0,  // 11: planner.v1.UnarchiveAreaResponse.area:type_name -> planner.v1.Area
+	1,  // 12: planner.v1.AreaService.CreateArea:input_type -> planner.v1.CreateAreaRequest
+	3,  // 13: planner.v1.AreaService.GetArea:input_type -> planner.v1.GetAreaRequest
+	5,  // 14: planner.v1.AreaService.ListAreas:input_type -> planner.v1.ListAreasRequest
+	7,  // 15: planner.v1.AreaService.UpdateArea:input_type -> planner.v1.UpdateAreaRequest
+	9,  // 16: planner.v1.AreaService.DeleteArea:input_type -> planner.v1.DeleteAreaRequest
+	11, // 17: planner.v1.AreaService.ReorderArea:input_type -> planner.v1.ReorderAreaRequest
+	13, // 18: planner.v1.AreaService.ArchiveArea:input_type -> planner.v1.ArchiveAreaRequest
+	15, // 19: planner.v1.AreaService.UnarchiveArea:input_type -> planner.v1.UnarchiveAreaRequest
+	2,  // 20: planner.v1.AreaService.CreateArea:output_type -> planner.v1.CreateAreaResponse
+	4,  // 21: planner.v1.AreaService.GetArea:output_type -> planner.v1.GetAreaResponse
+	6,  // 22: planner.v1.AreaService.ListAreas:output_type -> planner.v1.ListAreasResponse
+	8,  // 23: planner.v1.AreaService.UpdateArea:output_type -> planner.v1.UpdateAreaResponse
+	10, // 24: planner.v1.AreaService.DeleteArea:output_type -> planner.v1.DeleteAreaResponse
+	12, // 25: planner.v1.AreaService.ReorderArea:output_type -> planner.v1.ReorderAreaResponse
+	14, // 26: planner.v1.AreaService.ArchiveArea:output_type -> planner.v1.ArchiveAreaResponse
+	16, // 27: planner.v1.AreaService.UnarchiveArea:output_type -> planner.v1.UnarchiveAreaResponse
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_planner_v1_area_proto_init() }
@@ -723,14 +1145,16 @@ func file_planner_v1_area_proto_init() {
 	if File_planner_v1_area_proto != nil {
 		return
 	}
+	file_planner_v1_common_proto_init()
 	file_planner_v1_area_proto_msgTypes[7].OneofWrappers = []any{}
+	file_planner_v1_area_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_area_proto_rawDesc), len(file_planner_v1_area_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
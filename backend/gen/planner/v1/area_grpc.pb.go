@@ -19,11 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AreaService_CreateArea_FullMethodName = "/planner.v1.AreaService/CreateArea"
-	AreaService_GetArea_FullMethodName    = "/planner.v1.AreaService/GetArea"
-	AreaService_ListAreas_FullMethodName  = "/planner.v1.AreaService/ListAreas"
-	AreaService_UpdateArea_FullMethodName = "/planner.v1.AreaService/UpdateArea"
-	AreaService_DeleteArea_FullMethodName = "/planner.v1.AreaService/DeleteArea"
+	AreaService_CreateArea_FullMethodName    = "/planner.v1.AreaService/CreateArea"
+	AreaService_GetArea_FullMethodName       = "/planner.v1.AreaService/GetArea"
+	AreaService_ListAreas_FullMethodName     = "/planner.v1.AreaService/ListAreas"
+	AreaService_UpdateArea_FullMethodName    = "/planner.v1.AreaService/UpdateArea"
+	AreaService_DeleteArea_FullMethodName    = "/planner.v1.AreaService/DeleteArea"
+	AreaService_ReorderArea_FullMethodName   = "/planner.v1.AreaService/ReorderArea"
+	AreaService_ArchiveArea_FullMethodName   = "/planner.v1.AreaService/ArchiveArea"
+	AreaService_UnarchiveArea_FullMethodName = "/planner.v1.AreaService/UnarchiveArea"
 )
 
 // AreaServiceClient is the client API for AreaService service.
@@ -40,8 +43,14 @@ type AreaServiceClient interface {
 	ListAreas(ctx context.Context, in *ListAreasRequest, opts ...grpc.CallOption) (*ListAreasResponse, error)
 	// Update an existing area
 	UpdateArea(ctx context.Context, in *UpdateAreaRequest, opts ...grpc.CallOption) (*UpdateAreaResponse, error)
-	// Delete an area
+	// Move an area to the trash, restricting, cascading or reassigning its projects
 	DeleteArea(ctx context.Context, in *DeleteAreaRequest, opts ...grpc.CallOption) (*DeleteAreaResponse, error)
+	// Move an area to a new position in the manual order
+	ReorderArea(ctx context.Context, in *ReorderAreaRequest, opts ...grpc.CallOption) (*ReorderAreaResponse, error)
+	// Archive an area, hiding it and its projects and tasks from lists by default
+	ArchiveArea(ctx context.Context, in *ArchiveAreaRequest, opts ...grpc.CallOption) (*ArchiveAreaResponse, error)
+	// Unarchive an area, showing it and its projects and tasks again
+	UnarchiveArea(ctx context.Context, in *UnarchiveAreaRequest, opts ...grpc.CallOption) (*UnarchiveAreaResponse, error)
 }
 
 type areaServiceClient struct {
@@ -102,6 +111,36 @@ func (c *areaServiceClient) DeleteArea(ctx context.Context, in *DeleteAreaReques
 	return out, nil
 }
 
+func (c *areaServiceClient) ReorderArea(ctx context.Context, in *ReorderAreaRequest, opts ...grpc.CallOption) (*ReorderAreaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderAreaResponse)
+	err := c.cc.Invoke(ctx, AreaService_ReorderArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) ArchiveArea(ctx context.Context, in *ArchiveAreaRequest, opts ...grpc.CallOption) (*ArchiveAreaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveAreaResponse)
+	err := c.cc.Invoke(ctx, AreaService_ArchiveArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) UnarchiveArea(ctx context.Context, in *UnarchiveAreaRequest, opts ...grpc.CallOption) (*UnarchiveAreaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnarchiveAreaResponse)
+	err := c.cc.Invoke(ctx, AreaService_UnarchiveArea_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AreaServiceServer is the server API for AreaService service.
 // All implementations must embed UnimplementedAreaServiceServer
 // for forward compatibility.
@@ -116,8 +155,14 @@ type AreaServiceServer interface {
 	ListAreas(context.Context, *ListAreasRequest) (*ListAreasResponse, error)
 	// Update an existing area
 	UpdateArea(context.Context, *UpdateAreaRequest) (*UpdateAreaResponse, error)
-	// Delete an area
+	// Move an area to the trash, restricting, cascading or reassigning its projects
 	DeleteArea(context.Context, *DeleteAreaRequest) (*DeleteAreaResponse, error)
+	// Move an area to a new position in the manual order
+	ReorderArea(context.Context, *ReorderAreaRequest) (*ReorderAreaResponse, error)
+	// Archive an area, hiding it and its projects and tasks from lists by default
+	ArchiveArea(context.Context, *ArchiveAreaRequest) (*ArchiveAreaResponse, error)
+	// Unarchive an area, showing it and its projects and tasks again
+	UnarchiveArea(context.Context, *UnarchiveAreaRequest) (*UnarchiveAreaResponse, error)
 	mustEmbedUnimplementedAreaServiceServer()
 }
 
@@ -143,6 +188,15 @@ func (UnimplementedAreaServiceServer) UpdateArea(context.Context, *UpdateAreaReq
 func (UnimplementedAreaServiceServer) DeleteArea(context.Context, *DeleteAreaRequest) (*DeleteAreaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteArea not implemented")
 }
+func (UnimplementedAreaServiceServer) ReorderArea(context.Context, *ReorderAreaRequest) (*ReorderAreaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderArea not implemented")
+}
+func (UnimplementedAreaServiceServer) ArchiveArea(context.Context, *ArchiveAreaRequest) (*ArchiveAreaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveArea not implemented")
+}
+func (UnimplementedAreaServiceServer) UnarchiveArea(context.Context, *UnarchiveAreaRequest) (*UnarchiveAreaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnarchiveArea not implemented")
+}
 func (UnimplementedAreaServiceServer) mustEmbedUnimplementedAreaServiceServer() {}
 func (UnimplementedAreaServiceServer) testEmbeddedByValue()                     {}
 
@@ -254,6 +308,60 @@ func _AreaService_DeleteArea_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AreaService_ReorderArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).ReorderArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_ReorderArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).ReorderArea(ctx, req.(*ReorderAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_ArchiveArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).ArchiveArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_ArchiveArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).ArchiveArea(ctx, req.(*ArchiveAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_UnarchiveArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).UnarchiveArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_UnarchiveArea_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).UnarchiveArea(ctx, req.(*UnarchiveAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AreaService_ServiceDesc is the grpc.ServiceDesc for AreaService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +389,18 @@ var AreaService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArea",
 			Handler:    _AreaService_DeleteArea_Handler,
 		},
+		{
+			MethodName: "ReorderArea",
+			Handler:    _AreaService_ReorderArea_Handler,
+		},
+		{
+			MethodName: "ArchiveArea",
+			Handler:    _AreaService_ArchiveArea_Handler,
+		},
+		{
+			MethodName: "UnarchiveArea",
+			Handler:    _AreaService_UnarchiveArea_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "planner/v1/area.proto",
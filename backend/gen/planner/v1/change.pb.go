@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: planner/v1/change.proto
+
+package plannerv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChangeOperation is what happened to an item
+type ChangeOperation int32
+
+const (
+	// Not specified
+	ChangeOperation_CHANGE_OPERATION_UNSPECIFIED ChangeOperation = 0
+	// The item was created or restored from the trash
+	ChangeOperation_CHANGE_OPERATION_CREATE ChangeOperation = 1
+	// The item, or a checklist, tag, dependency or heading belonging to it, was changed
+	ChangeOperation_CHANGE_OPERATION_UPDATE ChangeOperation = 2
+	// The item was moved to the trash or permanently deleted
+	ChangeOperation_CHANGE_OPERATION_DELETE ChangeOperation = 3
+)
+
+// Enum value maps for ChangeOperation.
+var (
+	ChangeOperation_name = map[int32]string{
+		0: "CHANGE_OPERATION_UNSPECIFIED",
+		1: "CHANGE_OPERATION_CREATE",
+		2: "CHANGE_OPERATION_UPDATE",
+		3: "CHANGE_OPERATION_DELETE",
+	}
+	ChangeOperation_value = map[string]int32{
+		"CHANGE_OPERATION_UNSPECIFIED": 0,
+		"CHANGE_OPERATION_CREATE":      1,
+		"CHANGE_OPERATION_UPDATE":      2,
+		"CHANGE_OPERATION_DELETE":      3,
+	}
+)
+
+func (x ChangeOperation) Enum() *ChangeOperation {
+	p := new(ChangeOperation)
+	*p = x
+	return p
+}
+
+func (x ChangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_change_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeOperation) Type() protoreflect.EnumType {
+	return &file_planner_v1_change_proto_enumTypes[0]
+}
+
+func (x ChangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeOperation.Descriptor instead.
+func (ChangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_change_proto_rawDescGZIP(), []int{0}
+}
+
+// Change records a single create, update or delete of an area, project or task
+type Change struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of this change in the change log; watching from it resumes after this change
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Kind of the changed item
+	Type EntityType `protobuf:"varint,2,opt,name=type,proto3,enum=planner.v1.EntityType" json:"type,omitempty"`
+	// ID of the changed item
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// What happened to the item
+	Operation ChangeOperation `protobuf:"varint,4,opt,name=operation,proto3,enum=planner.v1.ChangeOperation" json:"operation,omitempty"`
+	// When the change was made
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	mi := &file_planner_v1_change_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_change_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_planner_v1_change_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Change) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *Change) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *Change) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Change) GetOperation() ChangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return ChangeOperation_CHANGE_OPERATION_UNSPECIFIED
+}
+
+func (x *Change) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+// Request to watch for changes
+type WatchChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cursor to resume from; changes made after it are sent first. Empty starts
+	// from the latest change.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Only send changes to items of these types (if provided)
+	Types         []EntityType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=planner.v1.EntityType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	mi := &file_planner_v1_change_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesRequest) ProtoMessage() {}
+
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_change_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchChangesRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_change_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchChangesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchChangesRequest) GetTypes() []EntityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// Response carrying a batch of changes, oldest first
+type WatchChangesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Changes made since the previous response; empty in the first response when
+	// there is nothing to catch up on
+	Changes []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Cursor to resume from after this response. It moves past changes that
+	// were filtered out, so prefer it to the cursor of the last change.
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchChangesResponse) Reset() {
+	*x = WatchChangesResponse{}
+	mi := &file_planner_v1_change_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChangesResponse) ProtoMessage() {}
+
+func (x *WatchChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_change_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChangesResponse.ProtoReflect.Descriptor instead.
+func (*WatchChangesResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_change_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchChangesResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WatchChangesResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+var File_planner_v1_change_proto protoreflect.FileDescriptor
+
+const file_planner_v1_change_proto_rawDesc = "" +
+	"\n" +
+	"\x17planner/v1/change.proto\x12\n" +
+	"planner.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17planner/v1/common.proto\"\xd2\x01\n" +
+	"\x06Change\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12*\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.planner.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x129\n" +
+	"\toperation\x18\x04 \x01(\x0e2\x1b.planner.v1.ChangeOperationR\toperation\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\x81\x01\n" +
+	"\x13WatchChangesRequest\x12)\n" +
+	"\x06cursor\x18\x01 \x01(\tB\x11\xbaH\x0er\f\x18\x142\b^[0-9]*$R\x06cursor\x12?\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x16.planner.v1.EntityTypeB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\x05types\"\\\n" +
+	"\x14WatchChangesResponse\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.planner.v1.ChangeR\achanges\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor*\x8a\x01\n" +
+	"\x0fChangeOperation\x12 \n" +
+	"\x1cCHANGE_OPERATION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_CREATE\x10\x01\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_UPDATE\x10\x02\x12\x1b\n" +
+	"\x17CHANGE_OPERATION_DELETE\x10\x032d\n" +
+	"\rChangeService\x12S\n" +
+	"\fWatchChanges\x12\x1f.planner.v1.WatchChangesRequest\x1a .planner.v1.WatchChangesResponse0\x01B\xa6\x01\n" +
+	"\x0ecom.planner.v1B\vChangeProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Planner.V1\xca\x02\n" +
+	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
+
+var (
+	file_planner_v1_change_proto_rawDescOnce sync.Once
+	file_planner_v1_change_proto_rawDescData []byte
+)
+
+func file_planner_v1_change_proto_rawDescGZIP() []byte {
+	file_planner_v1_change_proto_rawDescOnce.Do(func() {
+		file_planner_v1_change_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_planner_v1_change_proto_rawDesc), len(file_planner_v1_change_proto_rawDesc)))
+	})
+	return file_planner_v1_change_proto_rawDescData
+}
+
+var file_planner_v1_change_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_planner_v1_change_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_planner_v1_change_proto_goTypes = []any{
+	(ChangeOperation)(0),          // 0: planner.v1.ChangeOperation
+	(*Change)(nil),                // 1: planner.v1.Change
+	(*WatchChangesRequest)(nil),   // 2: planner.v1.WatchChangesRequest
+	(*WatchChangesResponse)(nil),  // 3: planner.v1.WatchChangesResponse
+	(EntityType)(0),               // 4: planner.v1.EntityType
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_planner_v1_change_proto_depIdxs = []int32{
+	4, // 0: planner.v1.Change.type:type_name -> planner.v1.EntityType
+	0, // 1: planner.v1.Change.operation:type_name -> planner.v1.ChangeOperation
+	5, // 2: planner.v1.Change.changed_at:type_name -> google.protobuf.Timestamp
+	4, // 3: planner.v1.WatchChangesRequest.types:type_name -> planner.v1.EntityType
+	1, // 4: planner.v1.WatchChangesResponse.changes:type_name -> planner.v1.Change
+	2, // 5: planner.v1.ChangeService.WatchChanges:input_type -> planner.v1.WatchChangesRequest
+	3, // 6: planner.v1.ChangeService.WatchChanges:output_type -> planner.v1.WatchChangesResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_planner_v1_change_proto_init() }
+func file_planner_v1_change_proto_init() {
+	if File_planner_v1_change_proto != nil {
+		return
+	}
+	file_planner_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_change_proto_rawDesc), len(file_planner_v1_change_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_planner_v1_change_proto_goTypes,
+		DependencyIndexes: file_planner_v1_change_proto_depIdxs,
+		EnumInfos:         file_planner_v1_change_proto_enumTypes,
+		MessageInfos:      file_planner_v1_change_proto_msgTypes,
+	}.Build()
+	File_planner_v1_change_proto = out.File
+	file_planner_v1_change_proto_goTypes = nil
+	file_planner_v1_change_proto_depIdxs = nil
+}
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: planner/v1/change.proto
+
+package plannerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ChangeService_WatchChanges_FullMethodName = "/planner.v1.ChangeService/WatchChanges"
+)
+
+// ChangeServiceClient is the client API for ChangeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ChangeService streams changes to areas, projects and tasks
+type ChangeServiceClient interface {
+	// WatchChanges sends the changes made after the request's cursor and then keeps
+	// sending new ones as they are made. If the cursor is older than the
+	// changes still kept, the call fails with FAILED_PRECONDITION: reload
+	// everything and watch again without a cursor.
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error)
+}
+
+type changeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangeServiceClient(cc grpc.ClientConnInterface) ChangeServiceClient {
+	return &changeServiceClient{cc}
+}
+
+func (c *changeServiceClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchChangesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ChangeService_ServiceDesc.Streams[0], ChangeService_WatchChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChangesRequest, WatchChangesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChangeService_WatchChangesClient = grpc.ServerStreamingClient[WatchChangesResponse]
+
+// ChangeServiceServer is the server API for ChangeService service.
+// All implementations must embed UnimplementedChangeServiceServer
+// for forward compatibility.
+//
+// ChangeService streams changes to areas, projects and tasks
+type ChangeServiceServer interface {
+	// WatchChanges sends the changes made after the request's cursor and then keeps
+	// sending new ones as they are made. If the cursor is older than the
+	// changes still kept, the call fails with FAILED_PRECONDITION: reload
+	// everything and watch again without a cursor.
+	WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error
+	mustEmbedUnimplementedChangeServiceServer()
+}
+
+// UnimplementedChangeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedChangeServiceServer struct{}
+
+func (UnimplementedChangeServiceServer) WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[WatchChangesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedChangeServiceServer) mustEmbedUnimplementedChangeServiceServer() {}
+func (UnimplementedChangeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeChangeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangeServiceServer will
+// result in compilation errors.
+type UnsafeChangeServiceServer interface {
+	mustEmbedUnimplementedChangeServiceServer()
+}
+
+func RegisterChangeServiceServer(s grpc.ServiceRegistrar, srv ChangeServiceServer) {
+	// If the following call panics, it indicates UnimplementedChangeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ChangeService_ServiceDesc, srv)
+}
+
+func _ChangeService_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChangeServiceServer).WatchChanges(m, &grpc.GenericServerStream[WatchChangesRequest, WatchChangesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ChangeService_WatchChangesServer = grpc.ServerStreamingServer[WatchChangesResponse]
+
+// ChangeService_ServiceDesc is the grpc.ServiceDesc for ChangeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChangeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "planner.v1.ChangeService",
+	HandlerType: (*ChangeServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _ChangeService_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "planner/v1/change.proto",
+}
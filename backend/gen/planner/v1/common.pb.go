@@ -0,0 +1,323 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: planner/v1/common.proto
+
+package plannerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DeleteMode controls what happens to the children of an item being deleted
+type DeleteMode int32
+
+const (
+	// Not specified; treated as DELETE_MODE_RESTRICT
+	DeleteMode_DELETE_MODE_UNSPECIFIED DeleteMode = 0
+	// Refuse to delete an item that still has children
+	DeleteMode_DELETE_MODE_RESTRICT DeleteMode = 1
+	// Delete the item together with all of its children
+	DeleteMode_DELETE_MODE_CASCADE DeleteMode = 2
+	// Move the children to another parent, then delete the item
+	DeleteMode_DELETE_MODE_REASSIGN DeleteMode = 3
+)
+
+// Enum value maps for DeleteMode.
+var (
+	DeleteMode_name = map[int32]string{
+		0: "DELETE_MODE_UNSPECIFIED",
+		1: "DELETE_MODE_RESTRICT",
+		2: "DELETE_MODE_CASCADE",
+		3: "DELETE_MODE_REASSIGN",
+	}
+	DeleteMode_value = map[string]int32{
+		"DELETE_MODE_UNSPECIFIED": 0,
+		"DELETE_MODE_RESTRICT":    1,
+		"DELETE_MODE_CASCADE":     2,
+		"DELETE_MODE_REASSIGN":    3,
+	}
+)
+
+func (x DeleteMode) Enum() *DeleteMode {
+	p := new(DeleteMode)
+	*p = x
+	return p
+}
+
+func (x DeleteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_common_proto_enumTypes[0].Descriptor()
+}
+
+func (DeleteMode) Type() protoreflect.EnumType {
+	return &file_planner_v1_common_proto_enumTypes[0]
+}
+
+func (x DeleteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteMode.Descriptor instead.
+func (DeleteMode) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_common_proto_rawDescGZIP(), []int{0}
+}
+
+// EntityType identifies the kind of item a generic request or result refers to
+type EntityType int32
+
+const (
+	// Not specified
+	EntityType_ENTITY_TYPE_UNSPECIFIED EntityType = 0
+	// An area
+	EntityType_ENTITY_TYPE_AREA EntityType = 1
+	// A project
+	EntityType_ENTITY_TYPE_PROJECT EntityType = 2
+	// A task
+	EntityType_ENTITY_TYPE_TASK EntityType = 3
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_AREA",
+		2: "ENTITY_TYPE_PROJECT",
+		3: "ENTITY_TYPE_TASK",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED": 0,
+		"ENTITY_TYPE_AREA":        1,
+		"ENTITY_TYPE_PROJECT":     2,
+		"ENTITY_TYPE_TASK":        3,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_common_proto_enumTypes[1].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_planner_v1_common_proto_enumTypes[1]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_common_proto_rawDescGZIP(), []int{1}
+}
+
+// ListOrder selects how List results are sorted
+type ListOrder int32
+
+const (
+	// Not specified; treated as LIST_ORDER_MANUAL
+	ListOrder_LIST_ORDER_UNSPECIFIED ListOrder = 0
+	// The order the user arranged items in with the Reorder RPCs
+	ListOrder_LIST_ORDER_MANUAL ListOrder = 1
+	// Newest first by creation time
+	ListOrder_LIST_ORDER_CREATED ListOrder = 2
+)
+
+// Enum value maps for ListOrder.
+var (
+	ListOrder_name = map[int32]string{
+		0: "LIST_ORDER_UNSPECIFIED",
+		1: "LIST_ORDER_MANUAL",
+		2: "LIST_ORDER_CREATED",
+	}
+	ListOrder_value = map[string]int32{
+		"LIST_ORDER_UNSPECIFIED": 0,
+		"LIST_ORDER_MANUAL":      1,
+		"LIST_ORDER_CREATED":     2,
+	}
+)
+
+func (x ListOrder) Enum() *ListOrder {
+	p := new(ListOrder)
+	*p = x
+	return p
+}
+
+func (x ListOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (ListOrder) Type() protoreflect.EnumType {
+	return &file_planner_v1_common_proto_enumTypes[2]
+}
+
+func (x ListOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListOrder.Descriptor instead.
+func (ListOrder) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
+// TagMatch controls how a list of tags filters results
+type TagMatch int32
+
+const (
+	// Not specified; treated as TAG_MATCH_ANY
+	TagMatch_TAG_MATCH_UNSPECIFIED TagMatch = 0
+	// Match items carrying at least one of the tags
+	TagMatch_TAG_MATCH_ANY TagMatch = 1
+	// Match items carrying every one of the tags
+	TagMatch_TAG_MATCH_ALL TagMatch = 2
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_UNSPECIFIED",
+		1: "TAG_MATCH_ANY",
+		2: "TAG_MATCH_ALL",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_UNSPECIFIED": 0,
+		"TAG_MATCH_ANY":         1,
+		"TAG_MATCH_ALL":         2,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_common_proto_enumTypes[3].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_planner_v1_common_proto_enumTypes[3]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_common_proto_rawDescGZIP(), []int{3}
+}
+
+var File_planner_v1_common_proto protoreflect.FileDescriptor
+
+const file_planner_v1_common_proto_rawDesc = "" +
+	"\n" +
+	"\x17planner/v1/common.proto\x12\n" +
+	"planner.v1*v\n" +
+	"\n" +
+	"DeleteMode\x12\x1b\n" +
+	"\x17DELETE_MODE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DELETE_MODE_RESTRICT\x10\x01\x12\x17\n" +
+	"\x13DELETE_MODE_CASCADE\x10\x02\x12\x18\n" +
+	"\x14DELETE_MODE_REASSIGN\x10\x03*n\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ENTITY_TYPE_AREA\x10\x01\x12\x17\n" +
+	"\x13ENTITY_TYPE_PROJECT\x10\x02\x12\x14\n" +
+	"\x10ENTITY_TYPE_TASK\x10\x03*V\n" +
+	"\tListOrder\x12\x1a\n" +
+	"\x16LIST_ORDER_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LIST_ORDER_MANUAL\x10\x01\x12\x16\n" +
+	"\x12LIST_ORDER_CREATED\x10\x02*K\n" +
+	"\bTagMatch\x12\x19\n" +
+	"\x15TAG_MATCH_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x01\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x02B\xa6\x01\n" +
+	"\x0ecom.planner.v1B\vCommonProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Planner.V1\xca\x02\n" +
+	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
+
+var (
+	file_planner_v1_common_proto_rawDescOnce sync.Once
+	file_planner_v1_common_proto_rawDescData []byte
+)
+
+func file_planner_v1_common_proto_rawDescGZIP() []byte {
+	file_planner_v1_common_proto_rawDescOnce.Do(func() {
+		file_planner_v1_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_planner_v1_common_proto_rawDesc), len(file_planner_v1_common_proto_rawDesc)))
+	})
+	return file_planner_v1_common_proto_rawDescData
+}
+
+var file_planner_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_planner_v1_common_proto_goTypes = []any{
+	(DeleteMode)(0), // 0: planner.v1.DeleteMode
+	(EntityType)(0), // 1: planner.v1.EntityType
+	(ListOrder)(0),  // 2: planner.v1.ListOrder
+	(TagMatch)(0),   // 3: planner.v1.TagMatch
+}
+var file_planner_v1_common_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_planner_v1_common_proto_init() }
+func file_planner_v1_common_proto_init() {
+	if File_planner_v1_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_common_proto_rawDesc), len(file_planner_v1_common_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_planner_v1_common_proto_goTypes,
+		DependencyIndexes: file_planner_v1_common_proto_depIdxs,
+		EnumInfos:         file_planner_v1_common_proto_enumTypes,
+	}.Build()
+	File_planner_v1_common_proto = out.File
+	file_planner_v1_common_proto_goTypes = nil
+	file_planner_v1_common_proto_depIdxs = nil
+}
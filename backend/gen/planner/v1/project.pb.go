@@ -23,6 +23,124 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProjectStatus represents where a project is in its lifecycle
+type ProjectStatus int32
+
+const (
+	// Status not specified
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED ProjectStatus = 0
+	// Project is being worked on
+	ProjectStatus_PROJECT_STATUS_ACTIVE ProjectStatus = 1
+	// Project is parked for some day in the future
+	ProjectStatus_PROJECT_STATUS_SOMEDAY ProjectStatus = 2
+	// Project has been completed
+	ProjectStatus_PROJECT_STATUS_COMPLETED ProjectStatus = 3
+	// Project has been put away for reference
+	ProjectStatus_PROJECT_STATUS_ARCHIVED ProjectStatus = 4
+)
+
+// Enum value maps for ProjectStatus.
+var (
+	ProjectStatus_name = map[int32]string{
+		0: "PROJECT_STATUS_UNSPECIFIED",
+		1: "PROJECT_STATUS_ACTIVE",
+		2: "PROJECT_STATUS_SOMEDAY",
+		3: "PROJECT_STATUS_COMPLETED",
+		4: "PROJECT_STATUS_ARCHIVED",
+	}
+	ProjectStatus_value = map[string]int32{
+		"PROJECT_STATUS_UNSPECIFIED": 0,
+		"PROJECT_STATUS_ACTIVE":      1,
+		"PROJECT_STATUS_SOMEDAY":     2,
+		"PROJECT_STATUS_COMPLETED":   3,
+		"PROJECT_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x ProjectStatus) Enum() *ProjectStatus {
+	p := new(ProjectStatus)
+	*p = x
+	return p
+}
+
+func (x ProjectStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_project_proto_enumTypes[0].Descriptor()
+}
+
+func (ProjectStatus) Type() protoreflect.EnumType {
+	return &file_planner_v1_project_proto_enumTypes[0]
+}
+
+func (x ProjectStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectStatus.Descriptor instead.
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+// OpenTaskAction controls what happens to the open tasks of a project being completed
+type OpenTaskAction int32
+
+const (
+	// Not specified; open tasks are left as they are
+	OpenTaskAction_OPEN_TASK_ACTION_UNSPECIFIED OpenTaskAction = 0
+	// Leave open tasks as they are
+	OpenTaskAction_OPEN_TASK_ACTION_LEAVE OpenTaskAction = 1
+	// Mark open tasks as completed
+	OpenTaskAction_OPEN_TASK_ACTION_COMPLETE OpenTaskAction = 2
+	// Mark open tasks as cancelled
+	OpenTaskAction_OPEN_TASK_ACTION_CANCEL OpenTaskAction = 3
+)
+
+// Enum value maps for OpenTaskAction.
+var (
+	OpenTaskAction_name = map[int32]string{
+		0: "OPEN_TASK_ACTION_UNSPECIFIED",
+		1: "OPEN_TASK_ACTION_LEAVE",
+		2: "OPEN_TASK_ACTION_COMPLETE",
+		3: "OPEN_TASK_ACTION_CANCEL",
+	}
+	OpenTaskAction_value = map[string]int32{
+		"OPEN_TASK_ACTION_UNSPECIFIED": 0,
+		"OPEN_TASK_ACTION_LEAVE":       1,
+		"OPEN_TASK_ACTION_COMPLETE":    2,
+		"OPEN_TASK_ACTION_CANCEL":      3,
+	}
+)
+
+func (x OpenTaskAction) Enum() *OpenTaskAction {
+	p := new(OpenTaskAction)
+	*p = x
+	return p
+}
+
+func (x OpenTaskAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpenTaskAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_project_proto_enumTypes[1].Descriptor()
+}
+
+func (OpenTaskAction) Type() protoreflect.EnumType {
+	return &file_planner_v1_project_proto_enumTypes[1]
+}
+
+func (x OpenTaskAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpenTaskAction.Descriptor instead.
+func (OpenTaskAction) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
 // Project represents a project within an area
 type Project struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -37,7 +155,22 @@ type Project struct {
 	// Timestamp when the project was created
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the project was last updated
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// IDs of the tags attached to the project
+	TagIds []string `protobuf:"bytes,7,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Lifecycle status of the project
+	Status ProjectStatus `protobuf:"varint,8,opt,name=status,proto3,enum=planner.v1.ProjectStatus" json:"status,omitempty"`
+	// Timestamp when the project was completed (unset unless completed)
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Timestamp when the project was archived (unset unless archived)
+	ArchivedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	// Optional date the project should be finished by
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Summary of the project's tasks
+	Progress *ProjectProgress `protobuf:"bytes,12,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Version of the project, increased by every update; pass it back as expected_version
+	// to update or delete the project only if nobody else has changed it since
+	Version       int64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +247,211 @@ func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Project) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Project) GetStatus() ProjectStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *Project) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Project) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Project) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *Project) GetProgress() *ProjectProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Project) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ProjectProgress summarises how far through its tasks a project is.
+// Cancelled tasks are not counted.
+type ProjectProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of open tasks
+	OpenTasks int32 `protobuf:"varint,1,opt,name=open_tasks,json=openTasks,proto3" json:"open_tasks,omitempty"`
+	// Number of completed tasks
+	CompletedTasks int32 `protobuf:"varint,2,opt,name=completed_tasks,json=completedTasks,proto3" json:"completed_tasks,omitempty"`
+	// Percentage of tasks that are completed, rounded down (0 when the project has no tasks)
+	PercentComplete int32 `protobuf:"varint,3,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	// Number of open tasks whose due date has passed
+	OverdueTasks  int32 `protobuf:"varint,4,opt,name=overdue_tasks,json=overdueTasks,proto3" json:"overdue_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectProgress) Reset() {
+	*x = ProjectProgress{}
+	mi := &file_planner_v1_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectProgress) ProtoMessage() {}
+
+func (x *ProjectProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectProgress.ProtoReflect.Descriptor instead.
+func (*ProjectProgress) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProjectProgress) GetOpenTasks() int32 {
+	if x != nil {
+		return x.OpenTasks
+	}
+	return 0
+}
+
+func (x *ProjectProgress) GetCompletedTasks() int32 {
+	if x != nil {
+		return x.CompletedTasks
+	}
+	return 0
+}
+
+func (x *ProjectProgress) GetPercentComplete() int32 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *ProjectProgress) GetOverdueTasks() int32 {
+	if x != nil {
+		return x.OverdueTasks
+	}
+	return 0
+}
+
+// Heading is a named section of a project that groups its tasks
+type Heading struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the heading
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Project the heading belongs to
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Name of the heading
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Timestamp when the heading was created
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the heading was last updated
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Heading) Reset() {
+	*x = Heading{}
+	mi := &file_planner_v1_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Heading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heading) ProtoMessage() {}
+
+func (x *Heading) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heading.ProtoReflect.Descriptor instead.
+func (*Heading) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Heading) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Heading) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Heading) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Heading) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Heading) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // Request to create a new project
 type CreateProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -122,14 +460,18 @@ type CreateProjectRequest struct {
 	// Area ID this project belongs to (required)
 	AreaId string `protobuf:"bytes,2,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
 	// Notes for the project
-	Notes         string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Notes string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	// IDs of the tags to attach to the project
+	TagIds []string `protobuf:"bytes,4,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Optional deadline
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProjectRequest) Reset() {
 	*x = CreateProjectRequest{}
-	mi := &file_planner_v1_project_proto_msgTypes[1]
+	mi := &file_planner_v1_project_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +483,7 @@ func (x *CreateProjectRequest) String() string {
 func (*CreateProjectRequest) ProtoMessage() {}
 
 func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[1]
+	mi := &file_planner_v1_project_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +496,7 @@ func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
 func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{1}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateProjectRequest) GetName() string {
@@ -178,6 +520,20 @@ func (x *CreateProjectRequest) GetNotes() string {
 	return ""
 }
 
+func (x *CreateProjectRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *CreateProjectRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
 // Response containing the created project
 type CreateProjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -189,7 +545,7 @@ type CreateProjectResponse struct {
 
 func (x *CreateProjectResponse) Reset() {
 	*x = CreateProjectResponse{}
-	mi := &file_planner_v1_project_proto_msgTypes[2]
+	mi := &file_planner_v1_project_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -201,7 +557,7 @@ func (x *CreateProjectResponse) String() string {
 func (*CreateProjectResponse) ProtoMessage() {}
 
 func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[2]
+	mi := &file_planner_v1_project_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -214,7 +570,7 @@ func (x *CreateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProjectResponse.ProtoReflect.Descriptor instead.
 func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{2}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateProjectResponse) GetProject() *Project {
@@ -235,7 +591,7 @@ type GetProjectRequest struct {
 
 func (x *GetProjectRequest) Reset() {
 	*x = GetProjectRequest{}
-	mi := &file_planner_v1_project_proto_msgTypes[3]
+	mi := &file_planner_v1_project_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +603,7 @@ func (x *GetProjectRequest) String() string {
 func (*GetProjectRequest) ProtoMessage() {}
 
 func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[3]
+	mi := &file_planner_v1_project_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +616,7 @@ func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{3}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProjectRequest) GetId() string {
@@ -281,7 +637,7 @@ type GetProjectResponse struct {
 
 func (x *GetProjectResponse) Reset() {
 	*x = GetProjectResponse{}
-	mi := &file_planner_v1_project_proto_msgTypes[4]
+	mi := &file_planner_v1_project_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -293,7 +649,7 @@ func (x *GetProjectResponse) String() string {
 func (*GetProjectResponse) ProtoMessage() {}
 
 func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[4]
+	mi := &file_planner_v1_project_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +662,7 @@ func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{4}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProjectResponse) GetProject() *Project {
@@ -321,17 +677,28 @@ type ListProjectsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Optional area ID to filter projects
 	AreaId *string `protobuf:"bytes,1,opt,name=area_id,json=areaId,proto3,oneof" json:"area_id,omitempty"`
-	// Optional pagination limit (future use)
+	// Maximum number of items to return (defaults to 50, capped at 500)
 	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional pagination token (future use)
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Token from a previous response's next_page_token to continue listing; the
+	// request's other fields, apart from page_size, must be the same as before
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return projects carrying these tags
+	TagIds []string `protobuf:"bytes,4,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Whether projects must carry any (the default) or all of tag_ids
+	TagMatch TagMatch `protobuf:"varint,5,opt,name=tag_match,json=tagMatch,proto3,enum=planner.v1.TagMatch" json:"tag_match,omitempty"`
+	// How to sort the projects (defaults to the manual order)
+	Order ListOrder `protobuf:"varint,6,opt,name=order,proto3,enum=planner.v1.ListOrder" json:"order,omitempty"`
+	// Optional status to filter projects
+	Status *ProjectStatus `protobuf:"varint,7,opt,name=status,proto3,enum=planner.v1.ProjectStatus,oneof" json:"status,omitempty"`
+	// Include projects in archived areas
+	IncludeArchived bool `protobuf:"varint,8,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListProjectsRequest) Reset() {
 	*x = ListProjectsRequest{}
-	mi := &file_planner_v1_project_proto_msgTypes[5]
+	mi := &file_planner_v1_project_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +710,7 @@ func (x *ListProjectsRequest) String() string {
 func (*ListProjectsRequest) ProtoMessage() {}
 
 func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[5]
+	mi := &file_planner_v1_project_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +723,7 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
 func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{5}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListProjectsRequest) GetAreaId() string {
@@ -380,12 +747,47 @@ func (x *ListProjectsRequest) GetPageToken() string {
 	return ""
 }
 
+func (x *ListProjectsRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListProjectsRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+func (x *ListProjectsRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *ListProjectsRequest) GetStatus() ProjectStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *ListProjectsRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
 // Response containing a list of projects
 type ListProjectsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// List of projects
 	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
-	// Token for next page (future use)
+	// Token for the next page, empty when there are no more results
 	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -393,7 +795,7 @@ type ListProjectsResponse struct {
 
 func (x *ListProjectsResponse) Reset() {
 	*x = ListProjectsResponse{}
-	mi := &file_planner_v1_project_proto_msgTypes[6]
+	mi := &file_planner_v1_project_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +807,7 @@ func (x *ListProjectsResponse) String() string {
 func (*ListProjectsResponse) ProtoMessage() {}
 
 func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[6]
+	mi := &file_planner_v1_project_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +820,7 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
 func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{6}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListProjectsResponse) GetProjects() []*Project {
@@ -443,14 +845,27 @@ type UpdateProjectRequest struct {
 	// New name (if provided)
 	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	// New notes (if provided)
-	Notes         *string `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Notes *string `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	// New area ID to move the project to (if provided)
+	AreaId *string `protobuf:"bytes,4,opt,name=area_id,json=areaId,proto3,oneof" json:"area_id,omitempty"`
+	// Replace the project's tags with tag_ids; an empty list removes all tags
+	SetTags bool `protobuf:"varint,5,opt,name=set_tags,json=setTags,proto3" json:"set_tags,omitempty"`
+	// New tag IDs (only used when set_tags is true)
+	TagIds []string `protobuf:"bytes,6,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// New deadline (if provided)
+	Deadline *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// Remove the deadline (takes precedence over deadline)
+	ClearDeadline bool `protobuf:"varint,8,opt,name=clear_deadline,json=clearDeadline,proto3" json:"clear_deadline,omitempty"`
+	// Only update the project if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,9,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateProjectRequest) Reset() {
 	*x = UpdateProjectRequest{}
-	mi := &file_planner_v1_project_proto_msgTypes[7]
+	mi := &file_planner_v1_project_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +877,7 @@ func (x *UpdateProjectRequest) String() string {
 func (*UpdateProjectRequest) ProtoMessage() {}
 
 func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[7]
+	mi := &file_planner_v1_project_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +890,7 @@ func (x *UpdateProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{7}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateProjectRequest) GetId() string {
@@ -499,6 +914,48 @@ func (x *UpdateProjectRequest) GetNotes() string {
 	return ""
 }
 
+func (x *UpdateProjectRequest) GetAreaId() string {
+	if x != nil && x.AreaId != nil {
+		return *x.AreaId
+	}
+	return ""
+}
+
+func (x *UpdateProjectRequest) GetSetTags() bool {
+	if x != nil {
+		return x.SetTags
+	}
+	return false
+}
+
+func (x *UpdateProjectRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+func (x *UpdateProjectRequest) GetClearDeadline() bool {
+	if x != nil {
+		return x.ClearDeadline
+	}
+	return false
+}
+
+func (x *UpdateProjectRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 // Response containing the updated project
 type UpdateProjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -510,7 +967,7 @@ type UpdateProjectResponse struct {
 
 func (x *UpdateProjectResponse) Reset() {
 	*x = UpdateProjectResponse{}
-	mi := &file_planner_v1_project_proto_msgTypes[8]
+	mi := &file_planner_v1_project_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +979,7 @@ func (x *UpdateProjectResponse) String() string {
 func (*UpdateProjectResponse) ProtoMessage() {}
 
 func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[8]
+	mi := &file_planner_v1_project_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +992,7 @@ func (x *UpdateProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProjectResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{8}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateProjectResponse) GetProject() *Project {
@@ -549,14 +1006,22 @@ func (x *UpdateProjectResponse) GetProject() *Project {
 type DeleteProjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// ID of the project to delete
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What to do with the project's tasks (defaults to restrict)
+	Mode DeleteMode `protobuf:"varint,2,opt,name=mode,proto3,enum=planner.v1.DeleteMode" json:"mode,omitempty"`
+	// Project to move the tasks to when mode is DELETE_MODE_REASSIGN
+	// (empty moves them to the Inbox)
+	ReassignProjectId string `protobuf:"bytes,3,opt,name=reassign_project_id,json=reassignProjectId,proto3" json:"reassign_project_id,omitempty"`
+	// Only delete the project if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteProjectRequest) Reset() {
 	*x = DeleteProjectRequest{}
-	mi := &file_planner_v1_project_proto_msgTypes[9]
+	mi := &file_planner_v1_project_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +1033,7 @@ func (x *DeleteProjectRequest) String() string {
 func (*DeleteProjectRequest) ProtoMessage() {}
 
 func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[9]
+	mi := &file_planner_v1_project_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +1046,7 @@ func (x *DeleteProjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{9}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteProjectRequest) GetId() string {
@@ -591,6 +1056,27 @@ func (x *DeleteProjectRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteProjectRequest) GetMode() DeleteMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DeleteMode_DELETE_MODE_UNSPECIFIED
+}
+
+func (x *DeleteProjectRequest) GetReassignProjectId() string {
+	if x != nil {
+		return x.ReassignProjectId
+	}
+	return ""
+}
+
+func (x *DeleteProjectRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 // Response confirming deletion
 type DeleteProjectResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -602,7 +1088,7 @@ type DeleteProjectResponse struct {
 
 func (x *DeleteProjectResponse) Reset() {
 	*x = DeleteProjectResponse{}
-	mi := &file_planner_v1_project_proto_msgTypes[10]
+	mi := &file_planner_v1_project_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +1100,7 @@ func (x *DeleteProjectResponse) String() string {
 func (*DeleteProjectResponse) ProtoMessage() {}
 
 func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_project_proto_msgTypes[10]
+	mi := &file_planner_v1_project_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +1113,7 @@ func (x *DeleteProjectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProjectResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_project_proto_rawDescGZIP(), []int{10}
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteProjectResponse) GetSuccess() bool {
@@ -637,62 +1123,1656 @@ func (x *DeleteProjectResponse) GetSuccess() bool {
 	return false
 }
 
-var File_planner_v1_project_proto protoreflect.FileDescriptor
+// Request to move a project to a new position among its area's projects
+type ReorderProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project to move
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the project it should come directly after (empty if only before_id is given)
+	AfterId string `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// ID of the project it should come directly before (empty if only after_id is given)
+	BeforeId      string `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_planner_v1_project_proto_rawDesc = "" +
-	"\n" +
-	"\x18planner/v1/project.proto\x12\n" +
-	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xd2\x01\n" +
-	"\aProject\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
-	"\aarea_id\x18\x03 \x01(\tR\x06areaId\x12\x14\n" +
-	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"y\n" +
-	"\x14CreateProjectRequest\x12\x1e\n" +
-	"\x04name\x18\x01 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12!\n" +
-	"\aarea_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06areaId\x12\x1e\n" +
-	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x05notes\"F\n" +
-	"\x15CreateProjectResponse\x12-\n" +
-	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"-\n" +
-	"\x11GetProjectRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"C\n" +
-	"\x12GetProjectResponse\x12-\n" +
-	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"\x85\x01\n" +
-	"\x13ListProjectsRequest\x12&\n" +
-	"\aarea_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06areaId\x88\x01\x01\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
-	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageTokenB\n" +
-	"\n" +
-	"\b_area_id\"o\n" +
-	"\x14ListProjectsResponse\x12/\n" +
-	"\bprojects\x18\x01 \x03(\v2\x13.planner.v1.ProjectR\bprojects\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
-	"\x14UpdateProjectRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
-	"\x04name\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12#\n" +
-	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x01R\x05notes\x88\x01\x01B\a\n" +
-	"\x05_nameB\b\n" +
-	"\x06_notes\"F\n" +
-	"\x15UpdateProjectResponse\x12-\n" +
-	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"0\n" +
-	"\x14DeleteProjectRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"1\n" +
-	"\x15DeleteProjectResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb2\x03\n" +
+func (x *ReorderProjectRequest) Reset() {
+	*x = ReorderProjectRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProjectRequest) ProtoMessage() {}
+
+func (x *ReorderProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProjectRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProjectRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReorderProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderProjectRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *ReorderProjectRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+// Response containing the moved project
+type ReorderProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The moved project
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProjectResponse) Reset() {
+	*x = ReorderProjectResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProjectResponse) ProtoMessage() {}
+
+func (x *ReorderProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProjectResponse.ProtoReflect.Descriptor instead.
+func (*ReorderProjectResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReorderProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// Request to complete a project
+type CompleteProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project to complete
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// What to do with the project's open tasks (defaults to leaving them open)
+	OpenTasks     OpenTaskAction `protobuf:"varint,2,opt,name=open_tasks,json=openTasks,proto3,enum=planner.v1.OpenTaskAction" json:"open_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectRequest) Reset() {
+	*x = CompleteProjectRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectRequest) ProtoMessage() {}
+
+func (x *CompleteProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectRequest.ProtoReflect.Descriptor instead.
+func (*CompleteProjectRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CompleteProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteProjectRequest) GetOpenTasks() OpenTaskAction {
+	if x != nil {
+		return x.OpenTasks
+	}
+	return OpenTaskAction_OPEN_TASK_ACTION_UNSPECIFIED
+}
+
+// Response containing the completed project
+type CompleteProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The completed project
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteProjectResponse) Reset() {
+	*x = CompleteProjectResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteProjectResponse) ProtoMessage() {}
+
+func (x *CompleteProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteProjectResponse.ProtoReflect.Descriptor instead.
+func (*CompleteProjectResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CompleteProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// Request to park a project for some day
+type DeferProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project to defer
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferProjectRequest) Reset() {
+	*x = DeferProjectRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferProjectRequest) ProtoMessage() {}
+
+func (x *DeferProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferProjectRequest.ProtoReflect.Descriptor instead.
+func (*DeferProjectRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeferProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the deferred project
+type DeferProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The deferred project
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferProjectResponse) Reset() {
+	*x = DeferProjectResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferProjectResponse) ProtoMessage() {}
+
+func (x *DeferProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferProjectResponse.ProtoReflect.Descriptor instead.
+func (*DeferProjectResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeferProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// Request to archive a project
+type ArchiveProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project to archive
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectRequest) Reset() {
+	*x = ArchiveProjectRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectRequest) ProtoMessage() {}
+
+func (x *ArchiveProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ArchiveProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the archived project
+type ArchiveProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The archived project
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveProjectResponse) Reset() {
+	*x = ArchiveProjectResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveProjectResponse) ProtoMessage() {}
+
+func (x *ArchiveProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveProjectResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveProjectResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArchiveProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// Request to make a project active again
+type ActivateProjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project to activate
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProjectRequest) Reset() {
+	*x = ActivateProjectRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProjectRequest) ProtoMessage() {}
+
+func (x *ActivateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProjectRequest.ProtoReflect.Descriptor instead.
+func (*ActivateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ActivateProjectRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the active project
+type ActivateProjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The active project
+	Project       *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateProjectResponse) Reset() {
+	*x = ActivateProjectResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateProjectResponse) ProtoMessage() {}
+
+func (x *ActivateProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateProjectResponse.ProtoReflect.Descriptor instead.
+func (*ActivateProjectResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ActivateProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// Request to add a heading to the end of a project
+type CreateHeadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project (required)
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Name of the heading (required)
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHeadingRequest) Reset() {
+	*x = CreateHeadingRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHeadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHeadingRequest) ProtoMessage() {}
+
+func (x *CreateHeadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHeadingRequest.ProtoReflect.Descriptor instead.
+func (*CreateHeadingRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateHeadingRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateHeadingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response containing the created heading
+type CreateHeadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created heading
+	Heading       *Heading `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHeadingResponse) Reset() {
+	*x = CreateHeadingResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHeadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHeadingResponse) ProtoMessage() {}
+
+func (x *CreateHeadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHeadingResponse.ProtoReflect.Descriptor instead.
+func (*CreateHeadingResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateHeadingResponse) GetHeading() *Heading {
+	if x != nil {
+		return x.Heading
+	}
+	return nil
+}
+
+// Request to rename a heading
+type RenameHeadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the heading
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name (required)
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameHeadingRequest) Reset() {
+	*x = RenameHeadingRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameHeadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameHeadingRequest) ProtoMessage() {}
+
+func (x *RenameHeadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameHeadingRequest.ProtoReflect.Descriptor instead.
+func (*RenameHeadingRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RenameHeadingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameHeadingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response containing the renamed heading
+type RenameHeadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The renamed heading
+	Heading       *Heading `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameHeadingResponse) Reset() {
+	*x = RenameHeadingResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameHeadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameHeadingResponse) ProtoMessage() {}
+
+func (x *RenameHeadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameHeadingResponse.ProtoReflect.Descriptor instead.
+func (*RenameHeadingResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RenameHeadingResponse) GetHeading() *Heading {
+	if x != nil {
+		return x.Heading
+	}
+	return nil
+}
+
+// Request to move a heading to a new position within its project
+type ReorderHeadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the heading to move
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the heading it should come directly after (empty if only before_id is given)
+	AfterId string `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// ID of the heading it should come directly before (empty if only after_id is given)
+	BeforeId      string `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHeadingRequest) Reset() {
+	*x = ReorderHeadingRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHeadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHeadingRequest) ProtoMessage() {}
+
+func (x *ReorderHeadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHeadingRequest.ProtoReflect.Descriptor instead.
+func (*ReorderHeadingRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReorderHeadingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReorderHeadingRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *ReorderHeadingRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+// Response containing the moved heading
+type ReorderHeadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The moved heading
+	Heading       *Heading `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderHeadingResponse) Reset() {
+	*x = ReorderHeadingResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderHeadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderHeadingResponse) ProtoMessage() {}
+
+func (x *ReorderHeadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderHeadingResponse.ProtoReflect.Descriptor instead.
+func (*ReorderHeadingResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReorderHeadingResponse) GetHeading() *Heading {
+	if x != nil {
+		return x.Heading
+	}
+	return nil
+}
+
+// Request to move a heading, with all of its tasks, to another project
+type MoveHeadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the heading
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the destination project
+	ProjectId     string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveHeadingRequest) Reset() {
+	*x = MoveHeadingRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveHeadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveHeadingRequest) ProtoMessage() {}
+
+func (x *MoveHeadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveHeadingRequest.ProtoReflect.Descriptor instead.
+func (*MoveHeadingRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MoveHeadingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveHeadingRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// Response containing the moved heading
+type MoveHeadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The heading, now at the end of the destination project
+	Heading       *Heading `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveHeadingResponse) Reset() {
+	*x = MoveHeadingResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveHeadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveHeadingResponse) ProtoMessage() {}
+
+func (x *MoveHeadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveHeadingResponse.ProtoReflect.Descriptor instead.
+func (*MoveHeadingResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MoveHeadingResponse) GetHeading() *Heading {
+	if x != nil {
+		return x.Heading
+	}
+	return nil
+}
+
+// Request to delete a heading
+type DeleteHeadingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the heading to delete
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHeadingRequest) Reset() {
+	*x = DeleteHeadingRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHeadingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHeadingRequest) ProtoMessage() {}
+
+func (x *DeleteHeadingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHeadingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHeadingRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteHeadingRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response confirming deletion
+type DeleteHeadingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success status
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHeadingResponse) Reset() {
+	*x = DeleteHeadingResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHeadingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHeadingResponse) ProtoMessage() {}
+
+func (x *DeleteHeadingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHeadingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHeadingResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteHeadingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request for a project's tasks grouped by heading
+type GetProjectOutlineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the project
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Also include completed and cancelled tasks
+	IncludeFinished bool `protobuf:"varint,2,opt,name=include_finished,json=includeFinished,proto3" json:"include_finished,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetProjectOutlineRequest) Reset() {
+	*x = GetProjectOutlineRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOutlineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOutlineRequest) ProtoMessage() {}
+
+func (x *GetProjectOutlineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOutlineRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectOutlineRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetProjectOutlineRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *GetProjectOutlineRequest) GetIncludeFinished() bool {
+	if x != nil {
+		return x.IncludeFinished
+	}
+	return false
+}
+
+// HeadingSection is a heading together with the tasks grouped under it
+type HeadingSection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The heading
+	Heading *Heading `protobuf:"bytes,1,opt,name=heading,proto3" json:"heading,omitempty"`
+	// Tasks under the heading, in manual order
+	Tasks         []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeadingSection) Reset() {
+	*x = HeadingSection{}
+	mi := &file_planner_v1_project_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeadingSection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeadingSection) ProtoMessage() {}
+
+func (x *HeadingSection) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeadingSection.ProtoReflect.Descriptor instead.
+func (*HeadingSection) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HeadingSection) GetHeading() *Heading {
+	if x != nil {
+		return x.Heading
+	}
+	return nil
+}
+
+func (x *HeadingSection) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Response containing a project's outline
+type GetProjectOutlineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The project
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// Tasks that are not under any heading, in manual order
+	Tasks []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// The project's headings in order, each with its tasks
+	Sections      []*HeadingSection `protobuf:"bytes,3,rep,name=sections,proto3" json:"sections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectOutlineResponse) Reset() {
+	*x = GetProjectOutlineResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectOutlineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectOutlineResponse) ProtoMessage() {}
+
+func (x *GetProjectOutlineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectOutlineResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectOutlineResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetProjectOutlineResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *GetProjectOutlineResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *GetProjectOutlineResponse) GetSections() []*HeadingSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// Request to create several projects at once
+type BatchCreateProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The projects to create, applied in order
+	Requests      []*CreateProjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateProjectsRequest) Reset() {
+	*x = BatchCreateProjectsRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsRequest) ProtoMessage() {}
+
+func (x *BatchCreateProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchCreateProjectsRequest) GetRequests() []*CreateProjectRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Response to a batch create of projects
+type BatchCreateProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created projects, in request order
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateProjectsResponse) Reset() {
+	*x = BatchCreateProjectsResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProjectsResponse) ProtoMessage() {}
+
+func (x *BatchCreateProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchCreateProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// Request to update several projects at once
+type BatchUpdateProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The projects to update, applied in order
+	Requests      []*UpdateProjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateProjectsRequest) Reset() {
+	*x = BatchUpdateProjectsRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateProjectsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchUpdateProjectsRequest) GetRequests() []*UpdateProjectRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Response to a batch update of projects
+type BatchUpdateProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated projects, in request order
+	Projects      []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateProjectsResponse) Reset() {
+	*x = BatchUpdateProjectsResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateProjectsResponse) ProtoMessage() {}
+
+func (x *BatchUpdateProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BatchUpdateProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// Request to delete several projects at once
+type BatchDeleteProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The projects to delete, applied in order
+	Requests      []*DeleteProjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteProjectsRequest) Reset() {
+	*x = BatchDeleteProjectsRequest{}
+	mi := &file_planner_v1_project_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteProjectsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteProjectsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BatchDeleteProjectsRequest) GetRequests() []*DeleteProjectRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Response to a batch delete of projects
+type BatchDeleteProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The result of each deletion, in request order
+	Results       []*DeleteProjectResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDeleteProjectsResponse) Reset() {
+	*x = BatchDeleteProjectsResponse{}
+	mi := &file_planner_v1_project_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDeleteProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteProjectsResponse) ProtoMessage() {}
+
+func (x *BatchDeleteProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_project_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteProjectsResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_project_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BatchDeleteProjectsResponse) GetResults() []*DeleteProjectResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_planner_v1_project_proto protoreflect.FileDescriptor
+
+const file_planner_v1_project_proto_rawDesc = "" +
+	"\n" +
+	"\x18planner/v1/project.proto\x12\n" +
+	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17planner/v1/common.proto\x1a\x15planner/v1/task.proto\"\xa5\x04\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\aarea_id\x18\x03 \x01(\tR\x06areaId\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
+	"\atag_ids\x18\a \x03(\tR\x06tagIds\x121\n" +
+	"\x06status\x18\b \x01(\x0e2\x19.planner.v1.ProjectStatusR\x06status\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12;\n" +
+	"\varchived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x126\n" +
+	"\bdeadline\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x127\n" +
+	"\bprogress\x18\f \x01(\v2\x1b.planner.v1.ProjectProgressR\bprogress\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\"\xa9\x01\n" +
+	"\x0fProjectProgress\x12\x1d\n" +
+	"\n" +
+	"open_tasks\x18\x01 \x01(\x05R\topenTasks\x12'\n" +
+	"\x0fcompleted_tasks\x18\x02 \x01(\x05R\x0ecompletedTasks\x12)\n" +
+	"\x10percent_complete\x18\x03 \x01(\x05R\x0fpercentComplete\x12#\n" +
+	"\roverdue_tasks\x18\x04 \x01(\x05R\foverdueTasks\"\xc2\x01\n" +
+	"\aHeading\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xdd\x01\n" +
+	"\x14CreateProjectRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12!\n" +
+	"\aarea_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06areaId\x12\x1e\n" +
+	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x05notes\x12*\n" +
+	"\atag_ids\x18\x04 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x126\n" +
+	"\bdeadline\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\"F\n" +
+	"\x15CreateProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"-\n" +
+	"\x11GetProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"C\n" +
+	"\x12GetProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"\xa6\x03\n" +
+	"\x13ListProjectsRequest\x12&\n" +
+	"\aarea_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06areaId\x88\x01\x01\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12*\n" +
+	"\atag_ids\x18\x04 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x12;\n" +
+	"\ttag_match\x18\x05 \x01(\x0e2\x14.planner.v1.TagMatchB\b\xbaH\x05\x82\x01\x02\x10\x01R\btagMatch\x125\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x15.planner.v1.ListOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05order\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2\x19.planner.v1.ProjectStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\x06status\x88\x01\x01\x12)\n" +
+	"\x10include_archived\x18\b \x01(\bR\x0fincludeArchivedB\n" +
+	"\n" +
+	"\b_area_idB\t\n" +
+	"\a_status\"o\n" +
+	"\x14ListProjectsResponse\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.planner.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x04\n" +
+	"\x14UpdateProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12#\n" +
+	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x01R\x05notes\x88\x01\x01\x12&\n" +
+	"\aarea_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\x06areaId\x88\x01\x01\x12\x19\n" +
+	"\bset_tags\x18\x05 \x01(\bR\asetTags\x12*\n" +
+	"\atag_ids\x18\x06 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x126\n" +
+	"\bdeadline\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12%\n" +
+	"\x0eclear_deadline\x18\b \x01(\bR\rclearDeadline\x127\n" +
+	"\x10expected_version\x18\t \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x03R\x0fexpectedVersion\x88\x01\x01:d\xbaHa\x1a_\n" +
+	"\x18tag_ids_require_set_tags\x12\x19tag_ids requires set_tags\x1a(this.set_tags || size(this.tag_ids) == 0B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_notesB\n" +
+	"\n" +
+	"\b_area_idB\x13\n" +
+	"\x11_expected_version\"F\n" +
+	"\x15UpdateProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"\xe1\x02\n" +
+	"\x14DeleteProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x124\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x16.planner.v1.DeleteModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode\x12;\n" +
+	"\x13reassign_project_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x11reassignProjectId\x127\n" +
+	"\x10expected_version\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x0fexpectedVersion\x88\x01\x01:n\xbaHk\x1ai\n" +
+	"\x19reassign_to_other_project\x12'reassign_project_id must differ from id\x1a#this.reassign_project_id != this.idB\x13\n" +
+	"\x11_expected_version\"1\n" +
+	"\x15DeleteProjectResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfa\x02\n" +
+	"\x15ReorderProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\bafter_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aafterId\x12(\n" +
+	"\tbefore_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bbeforeId:\xf4\x01\xbaH\xf0\x01\x1au\n" +
+	"\x12neighbour_required\x122at least one of after_id and before_id is required\x1a+this.after_id != '' || this.before_id != ''\x1aw\n" +
+	"\x12neighbour_not_self\x12*after_id and before_id must differ from id\x1a5this.after_id != this.id && this.before_id != this.id\"G\n" +
+	"\x16ReorderProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"w\n" +
+	"\x16CompleteProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12C\n" +
+	"\n" +
+	"open_tasks\x18\x02 \x01(\x0e2\x1a.planner.v1.OpenTaskActionB\b\xbaH\x05\x82\x01\x02\x10\x01R\topenTasks\"H\n" +
+	"\x17CompleteProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"/\n" +
+	"\x13DeferProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"E\n" +
+	"\x14DeferProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"1\n" +
+	"\x15ArchiveProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"G\n" +
+	"\x16ArchiveProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"2\n" +
+	"\x16ActivateProjectRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"H\n" +
+	"\x17ActivateProjectResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\"_\n" +
+	"\x14CreateHeadingRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\"F\n" +
+	"\x15CreateHeadingResponse\x12-\n" +
+	"\aheading\x18\x01 \x01(\v2\x13.planner.v1.HeadingR\aheading\"P\n" +
+	"\x14RenameHeadingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\"F\n" +
+	"\x15RenameHeadingResponse\x12-\n" +
+	"\aheading\x18\x01 \x01(\v2\x13.planner.v1.HeadingR\aheading\"\xfa\x02\n" +
+	"\x15ReorderHeadingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\bafter_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aafterId\x12(\n" +
+	"\tbefore_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bbeforeId:\xf4\x01\xbaH\xf0\x01\x1au\n" +
+	"\x12neighbour_required\x122at least one of after_id and before_id is required\x1a+this.after_id != '' || this.before_id != ''\x1aw\n" +
+	"\x12neighbour_not_self\x12*after_id and before_id must differ from id\x1a5this.after_id != this.id && this.before_id != this.id\"G\n" +
+	"\x16ReorderHeadingResponse\x12-\n" +
+	"\aheading\x18\x01 \x01(\v2\x13.planner.v1.HeadingR\aheading\"W\n" +
+	"\x12MoveHeadingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\"D\n" +
+	"\x13MoveHeadingResponse\x12-\n" +
+	"\aheading\x18\x01 \x01(\v2\x13.planner.v1.HeadingR\aheading\"0\n" +
+	"\x14DeleteHeadingRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"1\n" +
+	"\x15DeleteHeadingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"n\n" +
+	"\x18GetProjectOutlineRequest\x12'\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\x12)\n" +
+	"\x10include_finished\x18\x02 \x01(\bR\x0fincludeFinished\"g\n" +
+	"\x0eHeadingSection\x12-\n" +
+	"\aheading\x18\x01 \x01(\v2\x13.planner.v1.HeadingR\aheading\x12&\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x10.planner.v1.TaskR\x05tasks\"\xaa\x01\n" +
+	"\x19GetProjectOutlineResponse\x12-\n" +
+	"\aproject\x18\x01 \x01(\v2\x13.planner.v1.ProjectR\aproject\x12&\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x10.planner.v1.TaskR\x05tasks\x126\n" +
+	"\bsections\x18\x03 \x03(\v2\x1a.planner.v1.HeadingSectionR\bsections\"g\n" +
+	"\x1aBatchCreateProjectsRequest\x12I\n" +
+	"\brequests\x18\x01 \x03(\v2 .planner.v1.CreateProjectRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"N\n" +
+	"\x1bBatchCreateProjectsResponse\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.planner.v1.ProjectR\bprojects\"g\n" +
+	"\x1aBatchUpdateProjectsRequest\x12I\n" +
+	"\brequests\x18\x01 \x03(\v2 .planner.v1.UpdateProjectRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"N\n" +
+	"\x1bBatchUpdateProjectsResponse\x12/\n" +
+	"\bprojects\x18\x01 \x03(\v2\x13.planner.v1.ProjectR\bprojects\"g\n" +
+	"\x1aBatchDeleteProjectsRequest\x12I\n" +
+	"\brequests\x18\x01 \x03(\v2 .planner.v1.DeleteProjectRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"Z\n" +
+	"\x1bBatchDeleteProjectsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.planner.v1.DeleteProjectResponseR\aresults*\xa1\x01\n" +
+	"\rProjectStatus\x12\x1e\n" +
+	"\x1aPROJECT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PROJECT_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
+	"\x16PROJECT_STATUS_SOMEDAY\x10\x02\x12\x1c\n" +
+	"\x18PROJECT_STATUS_COMPLETED\x10\x03\x12\x1b\n" +
+	"\x17PROJECT_STATUS_ARCHIVED\x10\x04*\x8a\x01\n" +
+	"\x0eOpenTaskAction\x12 \n" +
+	"\x1cOPEN_TASK_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16OPEN_TASK_ACTION_LEAVE\x10\x01\x12\x1d\n" +
+	"\x19OPEN_TASK_ACTION_COMPLETE\x10\x02\x12\x1b\n" +
+	"\x17OPEN_TASK_ACTION_CANCEL\x10\x032\xb4\r\n" +
 	"\x0eProjectService\x12T\n" +
 	"\rCreateProject\x12 .planner.v1.CreateProjectRequest\x1a!.planner.v1.CreateProjectResponse\x12K\n" +
 	"\n" +
 	"GetProject\x12\x1d.planner.v1.GetProjectRequest\x1a\x1e.planner.v1.GetProjectResponse\x12Q\n" +
 	"\fListProjects\x12\x1f.planner.v1.ListProjectsRequest\x1a .planner.v1.ListProjectsResponse\x12T\n" +
 	"\rUpdateProject\x12 .planner.v1.UpdateProjectRequest\x1a!.planner.v1.UpdateProjectResponse\x12T\n" +
-	"\rDeleteProject\x12 .planner.v1.DeleteProjectRequest\x1a!.planner.v1.DeleteProjectResponseB\xa7\x01\n" +
+	"\rDeleteProject\x12 .planner.v1.DeleteProjectRequest\x1a!.planner.v1.DeleteProjectResponse\x12W\n" +
+	"\x0eReorderProject\x12!.planner.v1.ReorderProjectRequest\x1a\".planner.v1.ReorderProjectResponse\x12Z\n" +
+	"\x0fCompleteProject\x12\".planner.v1.CompleteProjectRequest\x1a#.planner.v1.CompleteProjectResponse\x12Q\n" +
+	"\fDeferProject\x12\x1f.planner.v1.DeferProjectRequest\x1a .planner.v1.DeferProjectResponse\x12W\n" +
+	"\x0eArchiveProject\x12!.planner.v1.ArchiveProjectRequest\x1a\".planner.v1.ArchiveProjectResponse\x12Z\n" +
+	"\x0fActivateProject\x12\".planner.v1.ActivateProjectRequest\x1a#.planner.v1.ActivateProjectResponse\x12`\n" +
+	"\x11GetProjectOutline\x12$.planner.v1.GetProjectOutlineRequest\x1a%.planner.v1.GetProjectOutlineResponse\x12T\n" +
+	"\rCreateHeading\x12 .planner.v1.CreateHeadingRequest\x1a!.planner.v1.CreateHeadingResponse\x12T\n" +
+	"\rRenameHeading\x12 .planner.v1.RenameHeadingRequest\x1a!.planner.v1.RenameHeadingResponse\x12W\n" +
+	"\x0eReorderHeading\x12!.planner.v1.ReorderHeadingRequest\x1a\".planner.v1.ReorderHeadingResponse\x12N\n" +
+	"\vMoveHeading\x12\x1e.planner.v1.MoveHeadingRequest\x1a\x1f.planner.v1.MoveHeadingResponse\x12T\n" +
+	"\rDeleteHeading\x12 .planner.v1.DeleteHeadingRequest\x1a!.planner.v1.DeleteHeadingResponse\x12f\n" +
+	"\x13BatchCreateProjects\x12&.planner.v1.BatchCreateProjectsRequest\x1a'.planner.v1.BatchCreateProjectsResponse\x12f\n" +
+	"\x13BatchUpdateProjects\x12&.planner.v1.BatchUpdateProjectsRequest\x1a'.planner.v1.BatchUpdateProjectsResponse\x12f\n" +
+	"\x13BatchDeleteProjects\x12&.planner.v1.BatchDeleteProjectsRequest\x1a'.planner.v1.BatchDeleteProjectsResponseB\xa7\x01\n" +
 	"\x0ecom.planner.v1B\fProjectProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Planner.V1\xca\x02\n" +
 	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
@@ -709,43 +2789,143 @@ func file_planner_v1_project_proto_rawDescGZIP() []byte {
 	return file_planner_v1_project_proto_rawDescData
 }
 
-var file_planner_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_planner_v1_project_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_planner_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_planner_v1_project_proto_goTypes = []any{
-	(*Project)(nil),               // 0: planner.v1.Project
-	(*CreateProjectRequest)(nil),  // 1: planner.v1.CreateProjectRequest
-	(*CreateProjectResponse)(nil), // 2: planner.v1.CreateProjectResponse
-	(*GetProjectRequest)(nil),     // 3: planner.v1.GetProjectRequest
-	(*GetProjectResponse)(nil),    // 4: planner.v1.GetProjectResponse
-	(*ListProjectsRequest)(nil),   // 5: planner.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),  // 6: planner.v1.ListProjectsResponse
-	(*UpdateProjectRequest)(nil),  // 7: planner.v1.UpdateProjectRequest
-	(*UpdateProjectResponse)(nil), // 8: planner.v1.UpdateProjectResponse
-	(*DeleteProjectRequest)(nil),  // 9: planner.v1.DeleteProjectRequest
-	(*DeleteProjectResponse)(nil), // 10: planner.v1.DeleteProjectResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(ProjectStatus)(0),                  // 0: planner.v1.ProjectStatus
+	(OpenTaskAction)(0),                 // 1: planner.v1.OpenTaskAction
+	(*Project)(nil),                     // 2: planner.v1.Project
+	(*ProjectProgress)(nil),             // 3: planner.v1.ProjectProgress
+	(*Heading)(nil),                     // 4: planner.v1.Heading
+	(*CreateProjectRequest)(nil),        // 5: planner.v1.CreateProjectRequest
+	(*CreateProjectResponse)(nil),       // 6: planner.v1.CreateProjectResponse
+	(*GetProjectRequest)(nil),           // 7: planner.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),          // 8: planner.v1.GetProjectResponse
+	(*ListProjectsRequest)(nil),         // 9: planner.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),        // 10: planner.v1.ListProjectsResponse
+	(*UpdateProjectRequest)(nil),        // 11: planner.v1.UpdateProjectRequest
+	(*UpdateProjectResponse)(nil),       // 12: planner.v1.UpdateProjectResponse
+	(*DeleteProjectRequest)(nil),        // 13: planner.v1.DeleteProjectRequest
+	(*DeleteProjectResponse)(nil),       // 14: planner.v1.DeleteProjectResponse
+	(*ReorderProjectRequest)(nil),       // 15: planner.v1.ReorderProjectRequest
+	(*ReorderProjectResponse)(nil),      // 16: planner.v1.ReorderProjectResponse
+	(*CompleteProjectRequest)(nil),      // 17: planner.v1.CompleteProjectRequest
+	(*CompleteProjectResponse)(nil),     // 18: planner.v1.CompleteProjectResponse
+	(*DeferProjectRequest)(nil),         // 19: planner.v1.DeferProjectRequest
+	(*DeferProjectResponse)(nil),        // 20: planner.v1.DeferProjectResponse
+	(*ArchiveProjectRequest)(nil),       // 21: planner.v1.ArchiveProjectRequest
+	(*ArchiveProjectResponse)(nil),      // 22: planner.v1.ArchiveProjectResponse
+	(*ActivateProjectRequest)(nil),      // 23: planner.v1.ActivateProjectRequest
+	(*ActivateProjectResponse)(nil),     // 24: planner.v1.ActivateProjectResponse
+	(*CreateHeadingRequest)(nil),        // 25: planner.v1.CreateHeadingRequest
+	(*CreateHeadingResponse)(nil),       // 26: planner.v1.CreateHeadingResponse
+	(*RenameHeadingRequest)(nil),        // 27: planner.v1.RenameHeadingRequest
+	(*RenameHeadingResponse)(nil),       // 28: planner.v1.RenameHeadingResponse
+	(*ReorderHeadingRequest)(nil),       // 29: planner.v1.ReorderHeadingRequest
+	(*ReorderHeadingResponse)(nil),      // 30: planner.v1.ReorderHeadingResponse
+	(*MoveHeadingRequest)(nil),          // 31: planner.v1.MoveHeadingRequest
+	(*MoveHeadingResponse)(nil),         // 32: planner.v1.MoveHeadingResponse
+	(*DeleteHeadingRequest)(nil),        // 33: planner.v1.DeleteHeadingRequest
+	(*DeleteHeadingResponse)(nil),       // 34: planner.v1.DeleteHeadingResponse
+	(*GetProjectOutlineRequest)(nil),    // 35: planner.v1.GetProjectOutlineRequest
+	(*HeadingSection)(nil),              // 36: planner.v1.HeadingSection
+	(*GetProjectOutlineResponse)(nil),   // 37: planner.v1.GetProjectOutlineResponse
+	(*BatchCreateProjectsRequest)(nil),  // 38: planner.v1.BatchCreateProjectsRequest
+	(*BatchCreateProjectsResponse)(nil), // 39: planner.v1.BatchCreateProjectsResponse
+	(*BatchUpdateProjectsRequest)(nil),  // 40: planner.v1.BatchUpdateProjectsRequest
+	(*BatchUpdateProjectsResponse)(nil), // 41: planner.v1.BatchUpdateProjectsResponse
+	(*BatchDeleteProjectsRequest)(nil),  // 42: planner.v1.BatchDeleteProjectsRequest
+	(*BatchDeleteProjectsResponse)(nil), // 43: planner.v1.BatchDeleteProjectsResponse
+	(*timestamppb.Timestamp)(nil),       // 44: google.protobuf.Timestamp
+	(TagMatch)(0),                       // 45: planner.v1.TagMatch
+	(ListOrder)(0),                      // 46: planner.v1.ListOrder
+	(DeleteMode)(0),                     // 47: planner.v1.DeleteMode
+	(*Task)(nil),                        // 48: planner.v1.Task
 }
 var file_planner_v1_project_proto_depIdxs = []int32{
-	11, // 0: planner.v1.Project.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: planner.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: planner.v1.CreateProjectResponse.project:type_name -> planner.v1.Project
-	0,  // 3: planner.v1.GetProjectResponse.project:type_name -> planner.v1.Project
-	0,  // 4: planner.v1.ListProjectsResponse.projects:type_name -> planner.v1.Project
-	0,  // 5: planner.v1.UpdateProjectResponse.project:type_name -> planner.v1.Project
-	1,  // 6: planner.v1.ProjectService.CreateProject:input_type -> planner.v1.CreateProjectRequest
-	3,  // 7: planner.v1.ProjectService.GetProject:input_type -> planner.v1.GetProjectRequest
-	5,  // 8: planner.v1.ProjectService.ListProjects:input_type -> planner.v1.ListProjectsRequest
-	7,  // 9: planner.v1.ProjectService.UpdateProject:input_type -> planner.v1.UpdateProjectRequest
-	9,  // 10: planner.v1.ProjectService.DeleteProject:input_type -> planner.v1.DeleteProjectRequest
-	2,  // 11: planner.v1.ProjectService.CreateProject:output_type -> planner.v1.CreateProjectResponse
-	4,  // 12: planner.v1.ProjectService.GetProject:output_type -> planner.v1.GetProjectResponse
-	6,  // 13: planner.v1.ProjectService.ListProjects:output_type -> planner.v1.ListProjectsResponse
-	8,  // 14: planner.v1.ProjectService.UpdateProject:output_type -> planner.v1.UpdateProjectResponse
-	10, // 15: planner.v1.ProjectService.DeleteProject:output_type -> planner.v1.DeleteProjectResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	44, // 0: planner.v1.Project.created_at:type_name -> google.protobuf.Timestamp
+	44, // 1: planner.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: planner.v1.Project.status:type_name -> planner.v1.ProjectStatus
+	44, // 3: planner.v1.Project.completed_at:type_name -> google.protobuf.Timestamp
+	44, // 4: planner.v1.Project.archived_at:type_name -> google.protobuf.Timestamp
+	44, // 5: planner.v1.Project.deadline:type_name -> google.protobuf.Timestamp
+	3,  // 6: planner.v1.Project.progress:type_name -> planner.v1.ProjectProgress
+	44, // 7: planner.v1.Heading.created_at:type_name -> google.protobuf.Timestamp
+	44, // 8: planner.v1.Heading.updated_at:type_name -> google.protobuf.Timestamp
+	44, // 9: planner.v1.CreateProjectRequest.deadline:type_name -> google.protobuf.Timestamp
+	2,  // 10: planner.v1.CreateProjectResponse.project:type_name -> planner.v1.Project
+	2,  // 11: planner.v1.GetProjectResponse.project:type_name -> planner.v1.Project
+	45, // 12: planner.v1.ListProjectsRequest.tag_match:type_name -> planner.v1.TagMatch
+	46, // 13: planner.v1.ListProjectsRequest.order:type_name -> planner.v1.ListOrder
+	0,  // 14: planner.v1.ListProjectsRequest.status:type_name -> planner.v1.ProjectStatus
+	2,  // 15: planner.v1.ListProjectsResponse.projects:type_name -> planner.v1.Project
+	44, // 16: planner.v1.UpdateProjectRequest.deadline:type_name -> google.protobuf.Timestamp
+	2,  // 17: planner.v1.UpdateProjectResponse.project:type_name -> planner.v1.Project
+	47, // 18: planner.v1.DeleteProjectRequest.mode:type_name -> planner.v1.DeleteMode
+	2,  // 19: planner.v1.ReorderProjectResponse.project:type_name -> planner.v1.Project
+	1,  // 20: planner.v1.CompleteProjectRequest.open_tasks:type_name -> planner.v1.OpenTaskAction
+	2,  // 21: planner.v1.CompleteProjectResponse.project:type_name -> planner.v1.Project
+	2,  // 22: planner.v1.DeferProjectResponse.project:type_name -> planner.v1.Project
+	2,  // 23: planner.v1.ArchiveProjectResponse.project:type_name -> planner.v1.Project
+	2,  // 24: planner.v1.ActivateProjectResponse.project:type_name -> planner.v1.Project
+	4,  // 25: planner.v1.CreateHeadingResponse.heading:type_name -> planner.v1.Heading
+	4,  // 26: planner.v1.RenameHeadingResponse.heading:type_name -> planner.v1.Heading
+	4,  // 27: planner.v1.ReorderHeadingResponse.heading:type_name -> planner.v1.Heading
+	4,  // 28: planner.v1.MoveHeadingResponse.heading:type_name -> planner.v1.Heading
+	4,  // 29: planner.v1.HeadingSection.heading:type_name -> planner.v1.Heading
+	48, // 30: planner.v1.HeadingSection.tasks:type_name -> planner.v1.Task
+	2,  // 31: planner.v1.GetProjectOutlineResponse.project:type_name -> planner.v1.Project
+	48, // 32: planner.v1.GetProjectOutlineResponse.tasks:type_name -> planner.v1.Task
+	36, // 33: planner.v1.GetProjectOutlineResponse.sections:type_name -> planner.v1.HeadingSection
+	5,  // 34: planner.v1.BatchCreateProjectsRequest.requests:type_name -> planner.v1.CreateProjectRequest
+	2,  // 35: planner.v1.BatchCreateProjectsResponse.projects:type_name -> planner.v1.Project
+	11, // 36: planner.v1.BatchUpdateProjectsRequest.requests:type_name -> planner.v1.UpdateProjectRequest
+	2,  // 37: planner.v1.BatchUpdateProjectsResponse.projects:type_name -> planner.v1.Project
+	13, // 38: planner.v1.BatchDeleteProjectsRequest.requests:type_name -> planner.v1.DeleteProjectRequest
+	14, // 39: planner.v1.BatchDeleteProjectsResponse.results:type_name -> planner.v1.DeleteProjectResponse
+	5,  // 40: planner.v1.ProjectService.CreateProject:input_type -> planner.v1.CreateProjectRequest
+	7,  // 41: planner.v1.ProjectService.GetProject:input_type -> planner.v1.GetProjectRequest
+	9,  // 42: planner.v1.ProjectService.ListProjects:input_type -> planner.v1.ListProjectsRequest
+	11, // 43: planner.v1.ProjectService.UpdateProject:input_type -> planner.v1.UpdateProjectRequest
+	13, // 44: planner.v1.ProjectService.DeleteProject:input_type -> planner.v1.DeleteProjectRequest
+	15, // 45: planner.v1.ProjectService.ReorderProject:input_type -> planner.v1.ReorderProjectRequest
+	17, // 46: planner.v1.ProjectService.CompleteProject:input_type -> planner.v1.CompleteProjectRequest
+	19, // 47: planner.v1.ProjectService.DeferProject:input_type -> planner.v1.DeferProjectRequest
+	21, // 48: planner.v1.ProjectService.ArchiveProject:input_type -> planner.v1.ArchiveProjectRequest
+	23, // 49: planner.v1.ProjectService.ActivateProject:input_type -> planner.v1.ActivateProjectRequest
+	35, // 50: planner.v1.ProjectService.GetProjectOutline:input_type -> planner.v1.GetProjectOutlineRequest
+	25, // 51: planner.v1.ProjectService.CreateHeading:input_type -> planner.v1.CreateHeadingRequest
+	27, // 52: planner.v1.ProjectService.RenameHeading:input_type -> planner.v1.RenameHeadingRequest
+	29, // 53: planner.v1.ProjectService.ReorderHeading:input_type -> planner.v1.ReorderHeadingRequest
+	31, // 54: planner.v1.ProjectService.MoveHeading:input_type -> planner.v1.MoveHeadingRequest
+	33, // 55: planner.v1.ProjectService.DeleteHeading:input_type -> planner.v1.DeleteHeadingRequest
+	38, // 56: planner.v1.ProjectService.BatchCreateProjects:input_type -> planner.v1.BatchCreateProjectsRequest
+	40, // 57: planner.v1.ProjectService.BatchUpdateProjects:input_type -> planner.v1.BatchUpdateProjectsRequest
+	42, // 58: planner.v1.ProjectService.BatchDeleteProjects:input_type -> planner.v1.BatchDeleteProjectsRequest
+	6,  // 59: planner.v1.ProjectService.CreateProject:output_type -> planner.v1.CreateProjectResponse
+	8,  // 60: planner.v1.ProjectService.GetProject:output_type -> planner.v1.GetProjectResponse
+	10, // 61: planner.v1.ProjectService.ListProjects:output_type -> planner.v1.ListProjectsResponse
+	12, // 62: planner.v1.ProjectService.UpdateProject:output_type -> planner.v1.UpdateProjectResponse
+	14, // 63: planner.v1.ProjectService.DeleteProject:output_type -> planner.v1.DeleteProjectResponse
+	16, // 64: planner.v1.ProjectService.ReorderProject:output_type -> planner.v1.ReorderProjectResponse
+	18, // 65: planner.v1.ProjectService.CompleteProject:output_type -> planner.v1.CompleteProjectResponse
+	20, // 66: planner.v1.ProjectService.DeferProject:output_type -> planner.v1.DeferProjectResponse
+	22, // 67: planner.v1.ProjectService.ArchiveProject:output_type -> planner.v1.ArchiveProjectResponse
+	24, // 68: planner.v1.ProjectService.ActivateProject:output_type -> planner.v1.ActivateProjectResponse
+	37, // 69: planner.v1.ProjectService.GetProjectOutline:output_type -> planner.v1.GetProjectOutlineResponse
+	26, // 70: planner.v1.ProjectService.CreateHeading:output_type -> planner.v1.CreateHeadingResponse
+	28, // 71: planner.v1.ProjectService.RenameHeading:output_type -> planner.v1.RenameHeadingResponse
+	30, // 72: planner.v1.ProjectService.ReorderHeading:output_type -> planner.v1.ReorderHeadingResponse
+	32, // 73: planner.v1.ProjectService.MoveHeading:output_type -> planner.v1.MoveHeadingResponse
+	34, // 74: planner.v1.ProjectService.DeleteHeading:output_type -> planner.v1.DeleteHeadingResponse
+	39, // 75: planner.v1.ProjectService.BatchCreateProjects:output_type -> planner.v1.BatchCreateProjectsResponse
+	41, // 76: planner.v1.ProjectService.BatchUpdateProjects:output_type -> planner.v1.BatchUpdateProjectsResponse
+	43, // 77: planner.v1.ProjectService.BatchDeleteProjects:output_type -> planner.v1.BatchDeleteProjectsResponse
+	59, // [59:78] is the sub-list for method output_type
+	40, // [40:59] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_planner_v1_project_proto_init() }
@@ -753,20 +2933,24 @@ func file_planner_v1_project_proto_init() {
 	if File_planner_v1_project_proto != nil {
 		return
 	}
-	file_planner_v1_project_proto_msgTypes[5].OneofWrappers = []any{}
+	file_planner_v1_common_proto_init()
+	file_planner_v1_task_proto_init()
 	file_planner_v1_project_proto_msgTypes[7].OneofWrappers = []any{}
+	file_planner_v1_project_proto_msgTypes[9].OneofWrappers = []any{}
+	file_planner_v1_project_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_project_proto_rawDesc), len(file_planner_v1_project_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_planner_v1_project_proto_goTypes,
 		DependencyIndexes: file_planner_v1_project_proto_depIdxs,
+		EnumInfos:         file_planner_v1_project_proto_enumTypes,
 		MessageInfos:      file_planner_v1_project_proto_msgTypes,
 	}.Build()
 	File_planner_v1_project_proto = out.File
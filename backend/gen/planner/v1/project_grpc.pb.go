@@ -19,11 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProjectService_CreateProject_FullMethodName = "/planner.v1.ProjectService/CreateProject"
-	ProjectService_GetProject_FullMethodName    = "/planner.v1.ProjectService/GetProject"
-	ProjectService_ListProjects_FullMethodName  = "/planner.v1.ProjectService/ListProjects"
-	ProjectService_UpdateProject_FullMethodName = "/planner.v1.ProjectService/UpdateProject"
-	ProjectService_DeleteProject_FullMethodName = "/planner.v1.ProjectService/DeleteProject"
+	ProjectService_CreateProject_FullMethodName       = "/planner.v1.ProjectService/CreateProject"
+	ProjectService_GetProject_FullMethodName          = "/planner.v1.ProjectService/GetProject"
+	ProjectService_ListProjects_FullMethodName        = "/planner.v1.ProjectService/ListProjects"
+	ProjectService_UpdateProject_FullMethodName       = "/planner.v1.ProjectService/UpdateProject"
+	ProjectService_DeleteProject_FullMethodName       = "/planner.v1.ProjectService/DeleteProject"
+	ProjectService_ReorderProject_FullMethodName      = "/planner.v1.ProjectService/ReorderProject"
+	ProjectService_CompleteProject_FullMethodName     = "/planner.v1.ProjectService/CompleteProject"
+	ProjectService_DeferProject_FullMethodName        = "/planner.v1.ProjectService/DeferProject"
+	ProjectService_ArchiveProject_FullMethodName      = "/planner.v1.ProjectService/ArchiveProject"
+	ProjectService_ActivateProject_FullMethodName     = "/planner.v1.ProjectService/ActivateProject"
+	ProjectService_GetProjectOutline_FullMethodName   = "/planner.v1.ProjectService/GetProjectOutline"
+	ProjectService_CreateHeading_FullMethodName       = "/planner.v1.ProjectService/CreateHeading"
+	ProjectService_RenameHeading_FullMethodName       = "/planner.v1.ProjectService/RenameHeading"
+	ProjectService_ReorderHeading_FullMethodName      = "/planner.v1.ProjectService/ReorderHeading"
+	ProjectService_MoveHeading_FullMethodName         = "/planner.v1.ProjectService/MoveHeading"
+	ProjectService_DeleteHeading_FullMethodName       = "/planner.v1.ProjectService/DeleteHeading"
+	ProjectService_BatchCreateProjects_FullMethodName = "/planner.v1.ProjectService/BatchCreateProjects"
+	ProjectService_BatchUpdateProjects_FullMethodName = "/planner.v1.ProjectService/BatchUpdateProjects"
+	ProjectService_BatchDeleteProjects_FullMethodName = "/planner.v1.ProjectService/BatchDeleteProjects"
 )
 
 // ProjectServiceClient is the client API for ProjectService service.
@@ -40,8 +54,38 @@ type ProjectServiceClient interface {
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	// Update an existing project
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
-	// Delete a project
+	// Move a project to the trash, restricting, cascading or reassigning its tasks
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	// Move a project to a new position among its area's projects
+	ReorderProject(ctx context.Context, in *ReorderProjectRequest, opts ...grpc.CallOption) (*ReorderProjectResponse, error)
+	// Complete an active or someday project, optionally completing or cancelling its open tasks
+	CompleteProject(ctx context.Context, in *CompleteProjectRequest, opts ...grpc.CallOption) (*CompleteProjectResponse, error)
+	// Park an active project for some day
+	DeferProject(ctx context.Context, in *DeferProjectRequest, opts ...grpc.CallOption) (*DeferProjectResponse, error)
+	// Archive a project that is not already archived
+	ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error)
+	// Make a someday, completed or archived project active again
+	ActivateProject(ctx context.Context, in *ActivateProjectRequest, opts ...grpc.CallOption) (*ActivateProjectResponse, error)
+	// Get a project's tasks grouped under its headings
+	GetProjectOutline(ctx context.Context, in *GetProjectOutlineRequest, opts ...grpc.CallOption) (*GetProjectOutlineResponse, error)
+	// Add a heading to the end of a project
+	CreateHeading(ctx context.Context, in *CreateHeadingRequest, opts ...grpc.CallOption) (*CreateHeadingResponse, error)
+	// Rename a heading
+	RenameHeading(ctx context.Context, in *RenameHeadingRequest, opts ...grpc.CallOption) (*RenameHeadingResponse, error)
+	// Move a heading to a new position within its project
+	ReorderHeading(ctx context.Context, in *ReorderHeadingRequest, opts ...grpc.CallOption) (*ReorderHeadingResponse, error)
+	// Move a heading and all of its tasks to another project
+	MoveHeading(ctx context.Context, in *MoveHeadingRequest, opts ...grpc.CallOption) (*MoveHeadingResponse, error)
+	// Delete a heading; its tasks stay in the project without a heading
+	DeleteHeading(ctx context.Context, in *DeleteHeadingRequest, opts ...grpc.CallOption) (*DeleteHeadingResponse, error)
+	// Create several projects in one transaction: either every request succeeds or
+	// none is applied. Every request is attempted; the error names the first
+	// request that failed and its BadRequest detail lists all of them
+	BatchCreateProjects(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error)
+	// Update several projects in one transaction, all or nothing like BatchCreateProjects
+	BatchUpdateProjects(ctx context.Context, in *BatchUpdateProjectsRequest, opts ...grpc.CallOption) (*BatchUpdateProjectsResponse, error)
+	// Delete several projects in one transaction, all or nothing like BatchCreateProjects
+	BatchDeleteProjects(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*BatchDeleteProjectsResponse, error)
 }
 
 type projectServiceClient struct {
@@ -102,6 +146,146 @@ func (c *projectServiceClient) DeleteProject(ctx context.Context, in *DeleteProj
 	return out, nil
 }
 
+func (c *projectServiceClient) ReorderProject(ctx context.Context, in *ReorderProjectRequest, opts ...grpc.CallOption) (*ReorderProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ReorderProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CompleteProject(ctx context.Context, in *CompleteProjectRequest, opts ...grpc.CallOption) (*CompleteProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CompleteProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeferProject(ctx context.Context, in *DeferProjectRequest, opts ...grpc.CallOption) (*DeferProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeferProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeferProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ArchiveProject(ctx context.Context, in *ArchiveProjectRequest, opts ...grpc.CallOption) (*ArchiveProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ArchiveProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ActivateProject(ctx context.Context, in *ActivateProjectRequest, opts ...grpc.CallOption) (*ActivateProjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ActivateProjectResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ActivateProject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) GetProjectOutline(ctx context.Context, in *GetProjectOutlineRequest, opts ...grpc.CallOption) (*GetProjectOutlineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProjectOutlineResponse)
+	err := c.cc.Invoke(ctx, ProjectService_GetProjectOutline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateHeading(ctx context.Context, in *CreateHeadingRequest, opts ...grpc.CallOption) (*CreateHeadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateHeadingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CreateHeading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) RenameHeading(ctx context.Context, in *RenameHeadingRequest, opts ...grpc.CallOption) (*RenameHeadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameHeadingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_RenameHeading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ReorderHeading(ctx context.Context, in *ReorderHeadingRequest, opts ...grpc.CallOption) (*ReorderHeadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderHeadingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ReorderHeading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) MoveHeading(ctx context.Context, in *MoveHeadingRequest, opts ...grpc.CallOption) (*MoveHeadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveHeadingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_MoveHeading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DeleteHeading(ctx context.Context, in *DeleteHeadingRequest, opts ...grpc.CallOption) (*DeleteHeadingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteHeadingResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DeleteHeading_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchCreateProjects(ctx context.Context, in *BatchCreateProjectsRequest, opts ...grpc.CallOption) (*BatchCreateProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_BatchCreateProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchUpdateProjects(ctx context.Context, in *BatchUpdateProjectsRequest, opts ...grpc.CallOption) (*BatchUpdateProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_BatchUpdateProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) BatchDeleteProjects(ctx context.Context, in *BatchDeleteProjectsRequest, opts ...grpc.CallOption) (*BatchDeleteProjectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteProjectsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_BatchDeleteProjects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProjectServiceServer is the server API for ProjectService service.
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility.
@@ -116,8 +300,38 @@ type ProjectServiceServer interface {
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	// Update an existing project
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
-	// Delete a project
+	// Move a project to the trash, restricting, cascading or reassigning its tasks
 	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	// Move a project to a new position among its area's projects
+	ReorderProject(context.Context, *ReorderProjectRequest) (*ReorderProjectResponse, error)
+	// Complete an active or someday project, optionally completing or cancelling its open tasks
+	CompleteProject(context.Context, *CompleteProjectRequest) (*CompleteProjectResponse, error)
+	// Park an active project for some day
+	DeferProject(context.Context, *DeferProjectRequest) (*DeferProjectResponse, error)
+	// Archive a project that is not already archived
+	ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error)
+	// Make a someday, completed or archived project active again
+	ActivateProject(context.Context, *ActivateProjectRequest) (*ActivateProjectResponse, error)
+	// Get a project's tasks grouped under its headings
+	GetProjectOutline(context.Context, *GetProjectOutlineRequest) (*GetProjectOutlineResponse, error)
+	// Add a heading to the end of a project
+	CreateHeading(context.Context, *CreateHeadingRequest) (*CreateHeadingResponse, error)
+	// Rename a heading
+	RenameHeading(context.Context, *RenameHeadingRequest) (*RenameHeadingResponse, error)
+	// Move a heading to a new position within its project
+	ReorderHeading(context.Context, *ReorderHeadingRequest) (*ReorderHeadingResponse, error)
+	// Move a heading and all of its tasks to another project
+	MoveHeading(context.Context, *MoveHeadingRequest) (*MoveHeadingResponse, error)
+	// Delete a heading; its tasks stay in the project without a heading
+	DeleteHeading(context.Context, *DeleteHeadingRequest) (*DeleteHeadingResponse, error)
+	// Create several projects in one transaction: either every request succeeds or
+	// none is applied. Every request is attempted; the error names the first
+	// request that failed and its BadRequest detail lists all of them
+	BatchCreateProjects(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error)
+	// Update several projects in one transaction, all or nothing like BatchCreateProjects
+	BatchUpdateProjects(context.Context, *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error)
+	// Delete several projects in one transaction, all or nothing like BatchCreateProjects
+	BatchDeleteProjects(context.Context, *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error)
 	mustEmbedUnimplementedProjectServiceServer()
 }
 
@@ -143,6 +357,48 @@ func (UnimplementedProjectServiceServer) UpdateProject(context.Context, *UpdateP
 func (UnimplementedProjectServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedProjectServiceServer) ReorderProject(context.Context, *ReorderProjectRequest) (*ReorderProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderProject not implemented")
+}
+func (UnimplementedProjectServiceServer) CompleteProject(context.Context, *CompleteProjectRequest) (*CompleteProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteProject not implemented")
+}
+func (UnimplementedProjectServiceServer) DeferProject(context.Context, *DeferProjectRequest) (*DeferProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeferProject not implemented")
+}
+func (UnimplementedProjectServiceServer) ArchiveProject(context.Context, *ArchiveProjectRequest) (*ArchiveProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveProject not implemented")
+}
+func (UnimplementedProjectServiceServer) ActivateProject(context.Context, *ActivateProjectRequest) (*ActivateProjectResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ActivateProject not implemented")
+}
+func (UnimplementedProjectServiceServer) GetProjectOutline(context.Context, *GetProjectOutlineRequest) (*GetProjectOutlineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectOutline not implemented")
+}
+func (UnimplementedProjectServiceServer) CreateHeading(context.Context, *CreateHeadingRequest) (*CreateHeadingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateHeading not implemented")
+}
+func (UnimplementedProjectServiceServer) RenameHeading(context.Context, *RenameHeadingRequest) (*RenameHeadingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameHeading not implemented")
+}
+func (UnimplementedProjectServiceServer) ReorderHeading(context.Context, *ReorderHeadingRequest) (*ReorderHeadingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderHeading not implemented")
+}
+func (UnimplementedProjectServiceServer) MoveHeading(context.Context, *MoveHeadingRequest) (*MoveHeadingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveHeading not implemented")
+}
+func (UnimplementedProjectServiceServer) DeleteHeading(context.Context, *DeleteHeadingRequest) (*DeleteHeadingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteHeading not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchCreateProjects(context.Context, *BatchCreateProjectsRequest) (*BatchCreateProjectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCreateProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchUpdateProjects(context.Context, *BatchUpdateProjectsRequest) (*BatchUpdateProjectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateProjects not implemented")
+}
+func (UnimplementedProjectServiceServer) BatchDeleteProjects(context.Context, *BatchDeleteProjectsRequest) (*BatchDeleteProjectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteProjects not implemented")
+}
 func (UnimplementedProjectServiceServer) mustEmbedUnimplementedProjectServiceServer() {}
 func (UnimplementedProjectServiceServer) testEmbeddedByValue()                        {}
 
@@ -254,6 +510,258 @@ func _ProjectService_DeleteProject_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ReorderProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ReorderProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ReorderProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ReorderProject(ctx, req.(*ReorderProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CompleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CompleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CompleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CompleteProject(ctx, req.(*CompleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeferProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeferProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeferProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeferProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeferProject(ctx, req.(*DeferProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ArchiveProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ArchiveProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ArchiveProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ArchiveProject(ctx, req.(*ArchiveProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ActivateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ActivateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ActivateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ActivateProject(ctx, req.(*ActivateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_GetProjectOutline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectOutlineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).GetProjectOutline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_GetProjectOutline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).GetProjectOutline(ctx, req.(*GetProjectOutlineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateHeading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHeadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateHeading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateHeading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateHeading(ctx, req.(*CreateHeadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_RenameHeading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameHeadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).RenameHeading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_RenameHeading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).RenameHeading(ctx, req.(*RenameHeadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ReorderHeading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderHeadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ReorderHeading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ReorderHeading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ReorderHeading(ctx, req.(*ReorderHeadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_MoveHeading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveHeadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).MoveHeading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_MoveHeading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).MoveHeading(ctx, req.(*MoveHeadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DeleteHeading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHeadingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DeleteHeading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DeleteHeading_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DeleteHeading(ctx, req.(*DeleteHeadingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchCreateProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchCreateProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_BatchCreateProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchCreateProjects(ctx, req.(*BatchCreateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchUpdateProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchUpdateProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_BatchUpdateProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchUpdateProjects(ctx, req.(*BatchUpdateProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_BatchDeleteProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).BatchDeleteProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_BatchDeleteProjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).BatchDeleteProjects(ctx, req.(*BatchDeleteProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProjectService_ServiceDesc is the grpc.ServiceDesc for ProjectService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +789,62 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _ProjectService_DeleteProject_Handler,
 		},
+		{
+			MethodName: "ReorderProject",
+			Handler:    _ProjectService_ReorderProject_Handler,
+		},
+		{
+			MethodName: "CompleteProject",
+			Handler:    _ProjectService_CompleteProject_Handler,
+		},
+		{
+			MethodName: "DeferProject",
+			Handler:    _ProjectService_DeferProject_Handler,
+		},
+		{
+			MethodName: "ArchiveProject",
+			Handler:    _ProjectService_ArchiveProject_Handler,
+		},
+		{
+			MethodName: "ActivateProject",
+			Handler:    _ProjectService_ActivateProject_Handler,
+		},
+		{
+			MethodName: "GetProjectOutline",
+			Handler:    _ProjectService_GetProjectOutline_Handler,
+		},
+		{
+			MethodName: "CreateHeading",
+			Handler:    _ProjectService_CreateHeading_Handler,
+		},
+		{
+			MethodName: "RenameHeading",
+			Handler:    _ProjectService_RenameHeading_Handler,
+		},
+		{
+			MethodName: "ReorderHeading",
+			Handler:    _ProjectService_ReorderHeading_Handler,
+		},
+		{
+			MethodName: "MoveHeading",
+			Handler:    _ProjectService_MoveHeading_Handler,
+		},
+		{
+			MethodName: "DeleteHeading",
+			Handler:    _ProjectService_DeleteHeading_Handler,
+		},
+		{
+			MethodName: "BatchCreateProjects",
+			Handler:    _ProjectService_BatchCreateProjects_Handler,
+		},
+		{
+			MethodName: "BatchUpdateProjects",
+			Handler:    _ProjectService_BatchUpdateProjects_Handler,
+		},
+		{
+			MethodName: "BatchDeleteProjects",
+			Handler:    _ProjectService_BatchDeleteProjects_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "planner/v1/project.proto",
@@ -0,0 +1,361 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: planner/v1/search.proto
+
+package plannerv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SearchResult is an area, project or task matching a search query
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the matching item
+	Type EntityType `protobuf:"varint,1,opt,name=type,proto3,enum=planner.v1.EntityType" json:"type,omitempty"`
+	// ID of the matching item
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the matching item
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Excerpt of the best matching text, HTML-escaped, with matched terms wrapped
+	// in <mark> and </mark>, so it can be rendered as HTML as it is.
+	Snippet string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// ID of the area the item is in (the item itself for areas; empty for Inbox tasks)
+	AreaId string `protobuf:"bytes,5,opt,name=area_id,json=areaId,proto3" json:"area_id,omitempty"`
+	// ID of the project the item is in (the item itself for projects; empty for areas and Inbox tasks)
+	ProjectId     string `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_planner_v1_search_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_search_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_planner_v1_search_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SearchResult) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *SearchResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchResult) GetAreaId() string {
+	if x != nil {
+		return x.AreaId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// Request to search areas, projects and tasks
+type SearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Words to search for; every word must match, and the last may be the start of a word
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only return items of these types (if provided)
+	Types []EntityType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=planner.v1.EntityType" json:"types,omitempty"`
+	// Only return items in this area (if provided)
+	AreaId *string `protobuf:"bytes,3,opt,name=area_id,json=areaId,proto3,oneof" json:"area_id,omitempty"`
+	// Only return tasks with this status (if provided); other types are unaffected
+	TaskStatus *TaskStatus `protobuf:"varint,4,opt,name=task_status,json=taskStatus,proto3,enum=planner.v1.TaskStatus,oneof" json:"task_status,omitempty"`
+	// Only return projects with this status (if provided); other types are unaffected
+	ProjectStatus *ProjectStatus `protobuf:"varint,5,opt,name=project_status,json=projectStatus,proto3,enum=planner.v1.ProjectStatus,oneof" json:"project_status,omitempty"`
+	// Include archived areas and the projects and tasks in them
+	IncludeArchived bool `protobuf:"varint,6,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	// Maximum number of results to return (defaults to 20, capped at 100)
+	Limit         int32 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_planner_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTypes() []EntityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchRequest) GetAreaId() string {
+	if x != nil && x.AreaId != nil {
+		return *x.AreaId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTaskStatus() TaskStatus {
+	if x != nil && x.TaskStatus != nil {
+		return *x.TaskStatus
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetProjectStatus() ProjectStatus {
+	if x != nil && x.ProjectStatus != nil {
+		return *x.ProjectStatus
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
+func (x *SearchRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Response containing the best matches first
+type SearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching items
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_planner_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_planner_v1_search_proto protoreflect.FileDescriptor
+
+const file_planner_v1_search_proto_rawDesc = "" +
+	"\n" +
+	"\x17planner/v1/search.proto\x12\n" +
+	"planner.v1\x1a\x1bbuf/validate/validate.proto\x1a\x17planner/v1/common.proto\x1a\x18planner/v1/project.proto\x1a\x15planner/v1/task.proto\"\xb0\x01\n" +
+	"\fSearchResult\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.planner.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x12\x17\n" +
+	"\aarea_id\x18\x05 \x01(\tR\x06areaId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x06 \x01(\tR\tprojectId\"\xac\x03\n" +
+	"\rSearchRequest\x12 \n" +
+	"\x05query\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xf4\x03R\x05query\x12?\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x16.planner.v1.EntityTypeB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\x05types\x12&\n" +
+	"\aarea_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06areaId\x88\x01\x01\x12F\n" +
+	"\vtask_status\x18\x04 \x01(\x0e2\x16.planner.v1.TaskStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\n" +
+	"taskStatus\x88\x01\x01\x12O\n" +
+	"\x0eproject_status\x18\x05 \x01(\x0e2\x19.planner.v1.ProjectStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\rprojectStatus\x88\x01\x01\x12)\n" +
+	"\x10include_archived\x18\x06 \x01(\bR\x0fincludeArchived\x12\x1d\n" +
+	"\x05limit\x18\a \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x05limitB\n" +
+	"\n" +
+	"\b_area_idB\x0e\n" +
+	"\f_task_statusB\x11\n" +
+	"\x0f_project_status\"D\n" +
+	"\x0eSearchResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.planner.v1.SearchResultR\aresults2P\n" +
+	"\rSearchService\x12?\n" +
+	"\x06Search\x12\x19.planner.v1.SearchRequest\x1a\x1a.planner.v1.SearchResponseB\xa6\x01\n" +
+	"\x0ecom.planner.v1B\vSearchProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Planner.V1\xca\x02\n" +
+	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
+
+var (
+	file_planner_v1_search_proto_rawDescOnce sync.Once
+	file_planner_v1_search_proto_rawDescData []byte
+)
+
+func file_planner_v1_search_proto_rawDescGZIP() []byte {
+	file_planner_v1_search_proto_rawDescOnce.Do(func() {
+		file_planner_v1_search_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_planner_v1_search_proto_rawDesc), len(file_planner_v1_search_proto_rawDesc)))
+	})
+	return file_planner_v1_search_proto_rawDescData
+}
+
+var file_planner_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_planner_v1_search_proto_goTypes = []any{
+	(*SearchResult)(nil),   // 0: planner.v1.SearchResult
+	(*SearchRequest)(nil),  // 1: planner.v1.SearchRequest
+	(*SearchResponse)(nil), // 2: planner.v1.SearchResponse
+	(EntityType)(0),        // 3: planner.v1.EntityType
+	(TaskStatus)(0),        // 4: planner.v1.TaskStatus
+	(ProjectStatus)(0),     // 5: planner.v1.ProjectStatus
+}
+var file_planner_v1_search_proto_depIdxs = []int32{
+	3, // 0: planner.v1.SearchResult.type:type_name -> planner.v1.EntityType
+	3, // 1: planner.v1.SearchRequest.types:type_name -> planner.v1.EntityType
+	4, // 2: planner.v1.SearchRequest.task_status:type_name -> planner.v1.TaskStatus
+	5, // 3: planner.v1.SearchRequest.project_status:type_name -> planner.v1.ProjectStatus
+	0, // 4: planner.v1.SearchResponse.results:type_name -> planner.v1.SearchResult
+	1, // 5: planner.v1.SearchService.Search:input_type -> planner.v1.SearchRequest
+	2, // 6: planner.v1.SearchService.Search:output_type -> planner.v1.SearchResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_planner_v1_search_proto_init() }
+func file_planner_v1_search_proto_init() {
+	if File_planner_v1_search_proto != nil {
+		return
+	}
+	file_planner_v1_common_proto_init()
+	file_planner_v1_project_proto_init()
+	file_planner_v1_task_proto_init()
+	file_planner_v1_search_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_search_proto_rawDesc), len(file_planner_v1_search_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_planner_v1_search_proto_goTypes,
+		DependencyIndexes: file_planner_v1_search_proto_depIdxs,
+		MessageInfos:      file_planner_v1_search_proto_msgTypes,
+	}.Build()
+	File_planner_v1_search_proto = out.File
+	file_planner_v1_search_proto_goTypes = nil
+	file_planner_v1_search_proto_depIdxs = nil
+}
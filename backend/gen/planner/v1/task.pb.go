@@ -23,7 +23,324 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Task represents a task within a project
+// TaskStatus represents the completion state of a task
+type TaskStatus int32
+
+const (
+	// Status not specified
+	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0
+	// Task is still to be done
+	TaskStatus_TASK_STATUS_OPEN TaskStatus = 1
+	// Task has been completed
+	TaskStatus_TASK_STATUS_COMPLETED TaskStatus = 2
+	// Task was cancelled without being completed
+	TaskStatus_TASK_STATUS_CANCELLED TaskStatus = 3
+)
+
+// Enum value maps for TaskStatus.
+var (
+	TaskStatus_name = map[int32]string{
+		0: "TASK_STATUS_UNSPECIFIED",
+		1: "TASK_STATUS_OPEN",
+		2: "TASK_STATUS_COMPLETED",
+		3: "TASK_STATUS_CANCELLED",
+	}
+	TaskStatus_value = map[string]int32{
+		"TASK_STATUS_UNSPECIFIED": 0,
+		"TASK_STATUS_OPEN":        1,
+		"TASK_STATUS_COMPLETED":   2,
+		"TASK_STATUS_CANCELLED":   3,
+	}
+)
+
+func (x TaskStatus) Enum() *TaskStatus {
+	p := new(TaskStatus)
+	*p = x
+	return p
+}
+
+func (x TaskStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_task_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskStatus) Type() protoreflect.EnumType {
+	return &file_planner_v1_task_proto_enumTypes[0]
+}
+
+func (x TaskStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskStatus.Descriptor instead.
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+// RecurrenceFrequency is the unit a recurring task repeats in
+type RecurrenceFrequency int32
+
+const (
+	// Frequency not specified
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	// Repeats every interval days
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY RecurrenceFrequency = 1
+	// Repeats every interval weeks, optionally on specific weekdays
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY RecurrenceFrequency = 2
+	// Repeats every interval months on the same day of the month
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY RecurrenceFrequency = 3
+	// Repeats every interval years on the same date
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY RecurrenceFrequency = 4
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKLY",
+		3: "RECURRENCE_FREQUENCY_MONTHLY",
+		4: "RECURRENCE_FREQUENCY_YEARLY",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKLY":      2,
+		"RECURRENCE_FREQUENCY_MONTHLY":     3,
+		"RECURRENCE_FREQUENCY_YEARLY":      4,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_task_proto_enumTypes[1].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_planner_v1_task_proto_enumTypes[1]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{1}
+}
+
+// RecurrenceMode controls what the next occurrence of a recurring task is scheduled from
+type RecurrenceMode int32
+
+const (
+	// Not specified; treated as RECURRENCE_MODE_FIXED_SCHEDULE
+	RecurrenceMode_RECURRENCE_MODE_UNSPECIFIED RecurrenceMode = 0
+	// Occurrences follow a fixed schedule based on the previous due date
+	RecurrenceMode_RECURRENCE_MODE_FIXED_SCHEDULE RecurrenceMode = 1
+	// The next occurrence is scheduled from when the previous one was completed
+	RecurrenceMode_RECURRENCE_MODE_AFTER_COMPLETION RecurrenceMode = 2
+)
+
+// Enum value maps for RecurrenceMode.
+var (
+	RecurrenceMode_name = map[int32]string{
+		0: "RECURRENCE_MODE_UNSPECIFIED",
+		1: "RECURRENCE_MODE_FIXED_SCHEDULE",
+		2: "RECURRENCE_MODE_AFTER_COMPLETION",
+	}
+	RecurrenceMode_value = map[string]int32{
+		"RECURRENCE_MODE_UNSPECIFIED":      0,
+		"RECURRENCE_MODE_FIXED_SCHEDULE":   1,
+		"RECURRENCE_MODE_AFTER_COMPLETION": 2,
+	}
+)
+
+func (x RecurrenceMode) Enum() *RecurrenceMode {
+	p := new(RecurrenceMode)
+	*p = x
+	return p
+}
+
+func (x RecurrenceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_task_proto_enumTypes[2].Descriptor()
+}
+
+func (RecurrenceMode) Type() protoreflect.EnumType {
+	return &file_planner_v1_task_proto_enumTypes[2]
+}
+
+func (x RecurrenceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceMode.Descriptor instead.
+func (RecurrenceMode) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+// Weekday identifies a day of the week
+type Weekday int32
+
+const (
+	// Day not specified
+	Weekday_WEEKDAY_UNSPECIFIED Weekday = 0
+	// Monday
+	Weekday_WEEKDAY_MONDAY Weekday = 1
+	// Tuesday
+	Weekday_WEEKDAY_TUESDAY Weekday = 2
+	// Wednesday
+	Weekday_WEEKDAY_WEDNESDAY Weekday = 3
+	// Thursday
+	Weekday_WEEKDAY_THURSDAY Weekday = 4
+	// Friday
+	Weekday_WEEKDAY_FRIDAY Weekday = 5
+	// Saturday
+	Weekday_WEEKDAY_SATURDAY Weekday = 6
+	// Sunday
+	Weekday_WEEKDAY_SUNDAY Weekday = 7
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_UNSPECIFIED",
+		1: "WEEKDAY_MONDAY",
+		2: "WEEKDAY_TUESDAY",
+		3: "WEEKDAY_WEDNESDAY",
+		4: "WEEKDAY_THURSDAY",
+		5: "WEEKDAY_FRIDAY",
+		6: "WEEKDAY_SATURDAY",
+		7: "WEEKDAY_SUNDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_UNSPECIFIED": 0,
+		"WEEKDAY_MONDAY":      1,
+		"WEEKDAY_TUESDAY":     2,
+		"WEEKDAY_WEDNESDAY":   3,
+		"WEEKDAY_THURSDAY":    4,
+		"WEEKDAY_FRIDAY":      5,
+		"WEEKDAY_SATURDAY":    6,
+		"WEEKDAY_SUNDAY":      7,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_planner_v1_task_proto_enumTypes[3].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_planner_v1_task_proto_enumTypes[3]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+// RecurrenceRule describes how a recurring task repeats
+type RecurrenceRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unit the task repeats in (required)
+	Frequency RecurrenceFrequency `protobuf:"varint,1,opt,name=frequency,proto3,enum=planner.v1.RecurrenceFrequency" json:"frequency,omitempty"`
+	// Number of frequency units between occurrences (defaults to 1)
+	Interval int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Days of the week to repeat on (weekly only; defaults to the weekday of the due date)
+	Weekdays []Weekday `protobuf:"varint,3,rep,packed,name=weekdays,proto3,enum=planner.v1.Weekday" json:"weekdays,omitempty"`
+	// Whether occurrences follow a fixed schedule or are scheduled after completion
+	Mode          RecurrenceMode `protobuf:"varint,4,opt,name=mode,proto3,enum=planner.v1.RecurrenceMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurrenceRule) Reset() {
+	*x = RecurrenceRule{}
+	mi := &file_planner_v1_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurrenceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurrenceRule) ProtoMessage() {}
+
+func (x *RecurrenceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurrenceRule.ProtoReflect.Descriptor instead.
+func (*RecurrenceRule) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecurrenceRule) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RecurrenceRule) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *RecurrenceRule) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *RecurrenceRule) GetMode() RecurrenceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RecurrenceMode_RECURRENCE_MODE_UNSPECIFIED
+}
+
+// Task represents a task within a project, or in the Inbox when it has no project
 type Task struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique identifier for the task
@@ -32,19 +349,48 @@ type Task struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Notes for the task
 	Notes string `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Project ID this task belongs to (required)
+	// Project ID this task belongs to (empty for Inbox tasks)
 	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 	// Timestamp when the task was created
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Timestamp when the task was last updated
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Completion state of the task
+	Status TaskStatus `protobuf:"varint,7,opt,name=status,proto3,enum=planner.v1.TaskStatus" json:"status,omitempty"`
+	// Timestamp when the task was completed or cancelled (unset while open)
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// Optional date by which the task should be done
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Optional date before which the task is deferred and not yet available
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// IDs of the tags attached to the task
+	TagIds []string `protobuf:"bytes,11,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Checklist items of the task, in order
+	Checklist []*ChecklistItem `protobuf:"bytes,12,rep,name=checklist,proto3" json:"checklist,omitempty"`
+	// Number of completed checklist items
+	ChecklistCompleted int32 `protobuf:"varint,13,opt,name=checklist_completed,json=checklistCompleted,proto3" json:"checklist_completed,omitempty"`
+	// Total number of checklist items
+	ChecklistTotal int32 `protobuf:"varint,14,opt,name=checklist_total,json=checklistTotal,proto3" json:"checklist_total,omitempty"`
+	// ID of the recurring series this task is an occurrence of (empty if it does not repeat)
+	SeriesId string `protobuf:"bytes,15,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Repeat rule of the series (unset if the task does not repeat)
+	Recurrence *RecurrenceRule `protobuf:"bytes,16,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// IDs of the tasks that must be done before this one can start
+	DependsOnIds []string `protobuf:"bytes,17,rep,name=depends_on_ids,json=dependsOnIds,proto3" json:"depends_on_ids,omitempty"`
+	// IDs of the dependencies that are still open; the task is blocked while this is non-empty
+	BlockedByIds []string `protobuf:"bytes,18,rep,name=blocked_by_ids,json=blockedByIds,proto3" json:"blocked_by_ids,omitempty"`
+	// ID of the project heading the task is grouped under (empty if none)
+	HeadingId string `protobuf:"bytes,19,opt,name=heading_id,json=headingId,proto3" json:"heading_id,omitempty"`
+	// Version of the task, increased by every update; pass it back as expected_version
+	// to update or delete the task only if nobody else has changed it since
+	Version       int64 `protobuf:"varint,20,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_planner_v1_task_proto_msgTypes[0]
+	mi := &file_planner_v1_task_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -56,7 +402,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[0]
+	mi := &file_planner_v1_task_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -69,7 +415,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{0}
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Task) GetId() string {
@@ -97,51 +443,2203 @@ func (x *Task) GetProjectId() string {
 	if x != nil {
 		return x.ProjectId
 	}
-	return ""
+	return ""
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Task) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Task) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Task) GetChecklist() []*ChecklistItem {
+	if x != nil {
+		return x.Checklist
+	}
+	return nil
+}
+
+func (x *Task) GetChecklistCompleted() int32 {
+	if x != nil {
+		return x.ChecklistCompleted
+	}
+	return 0
+}
+
+func (x *Task) GetChecklistTotal() int32 {
+	if x != nil {
+		return x.ChecklistTotal
+	}
+	return 0
+}
+
+func (x *Task) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Task) GetRecurrence() *RecurrenceRule {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Task) GetDependsOnIds() []string {
+	if x != nil {
+		return x.DependsOnIds
+	}
+	return nil
+}
+
+func (x *Task) GetBlockedByIds() []string {
+	if x != nil {
+		return x.BlockedByIds
+	}
+	return nil
+}
+
+func (x *Task) GetHeadingId() string {
+	if x != nil {
+		return x.HeadingId
+	}
+	return ""
+}
+
+func (x *Task) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// ChecklistItem is a single step that can be ticked off within a task
+type ChecklistItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier for the checklist item
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Task ID this item belongs to
+	TaskId string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Name of the item (required)
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the item has been ticked off
+	Completed bool `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Timestamp when the item was created
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Timestamp when the item was last updated
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_planner_v1_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChecklistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request to create a new task
+type CreateTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the task (required)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Notes for the task
+	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Project ID this task belongs to (leave empty to capture the task in the Inbox)
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Optional due date
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Optional start (deferred) date
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// IDs of the tags to attach to the task
+	TagIds []string `protobuf:"bytes,6,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Heading of the project to group the task under (optional)
+	HeadingId     string `protobuf:"bytes,7,opt,name=heading_id,json=headingId,proto3" json:"heading_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTaskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetHeadingId() string {
+	if x != nil {
+		return x.HeadingId
+	}
+	return ""
+}
+
+// Response containing the created task
+type CreateTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to get a task by ID
+type GetTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to retrieve
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the requested task
+type GetTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The requested task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to list tasks
+type ListTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional project ID to filter tasks
+	ProjectId *string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Maximum number of items to return (defaults to 50, capped at 500)
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous response's next_page_token to continue listing; the
+	// request's other fields, apart from page_size, must be the same as before
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Optional status to filter tasks
+	Status *TaskStatus `protobuf:"varint,4,opt,name=status,proto3,enum=planner.v1.TaskStatus,oneof" json:"status,omitempty"`
+	// Only return tasks completed or cancelled at or after this time
+	CompletedAfter *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_after,json=completedAfter,proto3" json:"completed_after,omitempty"`
+	// Only return tasks completed or cancelled before this time
+	CompletedBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_before,json=completedBefore,proto3" json:"completed_before,omitempty"`
+	// Only return tasks due at or after this time
+	DueAfter *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_after,json=dueAfter,proto3" json:"due_after,omitempty"`
+	// Only return tasks due before this time
+	DueBefore *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_before,json=dueBefore,proto3" json:"due_before,omitempty"`
+	// Only return tasks that are available now (no start date, or start date has passed)
+	AvailableNow bool `protobuf:"varint,9,opt,name=available_now,json=availableNow,proto3" json:"available_now,omitempty"`
+	// Only return Inbox tasks (tasks without a project)
+	Inbox bool `protobuf:"varint,10,opt,name=inbox,proto3" json:"inbox,omitempty"`
+	// Only return tasks carrying these tags
+	TagIds []string `protobuf:"bytes,11,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Whether tasks must carry any (the default) or all of tag_ids
+	TagMatch TagMatch `protobuf:"varint,12,opt,name=tag_match,json=tagMatch,proto3,enum=planner.v1.TagMatch" json:"tag_match,omitempty"`
+	// Only return tasks with no open dependencies
+	OnlyUnblocked bool `protobuf:"varint,13,opt,name=only_unblocked,json=onlyUnblocked,proto3" json:"only_unblocked,omitempty"`
+	// How to sort the tasks (defaults to the manual order)
+	Order ListOrder `protobuf:"varint,14,opt,name=order,proto3,enum=planner.v1.ListOrder" json:"order,omitempty"`
+	// Include tasks in projects of archived areas
+	IncludeArchived bool `protobuf:"varint,15,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTasksRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetCompletedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetCompletedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedBefore
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetDueAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAfter
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetAvailableNow() bool {
+	if x != nil {
+		return x.AvailableNow
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetInbox() bool {
+	if x != nil {
+		return x.Inbox
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetOnlyUnblocked() bool {
+	if x != nil {
+		return x.OnlyUnblocked
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetOrder() ListOrder {
+	if x != nil {
+		return x.Order
+	}
+	return ListOrder_LIST_ORDER_UNSPECIFIED
+}
+
+func (x *ListTasksRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// Response containing a list of tasks
+type ListTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of tasks
+	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// Token for the next page, empty when there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to update an existing task
+type UpdateTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to update
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New name (if provided)
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// New notes (if provided)
+	Notes *string `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	// New due date (if provided)
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// New start date (if provided)
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Remove the due date (takes precedence over due_date)
+	ClearDueDate bool `protobuf:"varint,6,opt,name=clear_due_date,json=clearDueDate,proto3" json:"clear_due_date,omitempty"`
+	// Remove the start date (takes precedence over start_date)
+	ClearStartDate bool `protobuf:"varint,7,opt,name=clear_start_date,json=clearStartDate,proto3" json:"clear_start_date,omitempty"`
+	// New project ID to move the task to (if provided)
+	ProjectId *string `protobuf:"bytes,8,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
+	// Move the task to the Inbox (takes precedence over project_id)
+	ClearProject bool `protobuf:"varint,9,opt,name=clear_project,json=clearProject,proto3" json:"clear_project,omitempty"`
+	// Replace the task's tags with tag_ids; an empty list removes all tags
+	SetTags bool `protobuf:"varint,10,opt,name=set_tags,json=setTags,proto3" json:"set_tags,omitempty"`
+	// New tag IDs (only used when set_tags is true)
+	TagIds []string `protobuf:"bytes,11,rep,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	// Heading to group the task under (if provided); it must belong to the task's project.
+	// Moving the task to another project removes it from its heading.
+	HeadingId *string `protobuf:"bytes,12,opt,name=heading_id,json=headingId,proto3,oneof" json:"heading_id,omitempty"`
+	// Remove the task from its heading (takes precedence over heading_id)
+	ClearHeading bool `protobuf:"varint,13,opt,name=clear_heading,json=clearHeading,proto3" json:"clear_heading,omitempty"`
+	// Only update the task if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,14,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetClearDueDate() bool {
+	if x != nil {
+		return x.ClearDueDate
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetClearStartDate() bool {
+	if x != nil {
+		return x.ClearStartDate
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetClearProject() bool {
+	if x != nil {
+		return x.ClearProject
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetSetTags() bool {
+	if x != nil {
+		return x.SetTags
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetTagIds() []string {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetHeadingId() string {
+	if x != nil && x.HeadingId != nil {
+		return *x.HeadingId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetClearHeading() bool {
+	if x != nil {
+		return x.ClearHeading
+	}
+	return false
+}
+
+func (x *UpdateTaskRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+// Response containing the updated task
+type UpdateTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to delete a task
+type DeleteTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to delete
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only delete the task if it is still at this version (if provided);
+	// otherwise the call fails with ABORTED
+	ExpectedVersion *int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteTaskRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+// Response confirming deletion
+type DeleteTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success status
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request to complete a task
+type CompleteTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to complete
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Mark the task as cancelled rather than completed
+	Cancel        bool `protobuf:"varint,2,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskRequest) Reset() {
+	*x = CompleteTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskRequest) ProtoMessage() {}
+
+func (x *CompleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CompleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteTaskRequest) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+// Response containing the completed task
+type CompleteTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The completed task
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// The next occurrence, if the completed task repeats and its series had no
+	// other open occurrence
+	NextTask      *Task `protobuf:"bytes,2,opt,name=next_task,json=nextTask,proto3" json:"next_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTaskResponse) Reset() {
+	*x = CompleteTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTaskResponse) ProtoMessage() {}
+
+func (x *CompleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompleteTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CompleteTaskResponse) GetNextTask() *Task {
+	if x != nil {
+		return x.NextTask
+	}
+	return nil
+}
+
+// Request to reopen a completed or cancelled task
+type ReopenTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to reopen
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskRequest) Reset() {
+	*x = ReopenTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskRequest) ProtoMessage() {}
+
+func (x *ReopenTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReopenTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReopenTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the reopened task
+type ReopenTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The reopened task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenTaskResponse) Reset() {
+	*x = ReopenTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenTaskResponse) ProtoMessage() {}
+
+func (x *ReopenTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReopenTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReopenTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to file a task into a project
+type FileTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to file
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the project to file the task into
+	ProjectId     string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTaskRequest) Reset() {
+	*x = FileTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTaskRequest) ProtoMessage() {}
+
+func (x *FileTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTaskRequest.ProtoReflect.Descriptor instead.
+func (*FileTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FileTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FileTaskRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// Response containing the filed task
+type FileTaskResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The filed task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileTaskResponse) Reset() {
+	*x = FileTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTaskResponse) ProtoMessage() {}
+
+func (x *FileTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTaskResponse.ProtoReflect.Descriptor instead.
+func (*FileTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to move several tasks at once
+type MoveTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs of the tasks to move
+	TaskIds []string `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	// ID of the destination project (empty moves the tasks to the Inbox)
+	ProjectId     string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTasksRequest) Reset() {
+	*x = MoveTasksRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTasksRequest) ProtoMessage() {}
+
+func (x *MoveTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTasksRequest.ProtoReflect.Descriptor instead.
+func (*MoveTasksRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MoveTasksRequest) GetTaskIds() []string {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+func (x *MoveTasksRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// Response containing the moved tasks
+type MoveTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The moved tasks, in request order
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTasksResponse) Reset() {
+	*x = MoveTasksResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTasksResponse) ProtoMessage() {}
+
+func (x *MoveTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTasksResponse.ProtoReflect.Descriptor instead.
+func (*MoveTasksResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MoveTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Request to add an item to the end of a task's checklist
+type CreateChecklistItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task to add the item to
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Name of the item (required)
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemRequest) Reset() {
+	*x = CreateChecklistItemRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemRequest) ProtoMessage() {}
+
+func (x *CreateChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateChecklistItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response containing the created checklist item
+type CreateChecklistItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created item
+	Item          *ChecklistItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChecklistItemResponse) Reset() {
+	*x = CreateChecklistItemResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChecklistItemResponse) ProtoMessage() {}
+
+func (x *CreateChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*CreateChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateChecklistItemResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// Request to tick off or untick a checklist item
+type ToggleChecklistItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the checklist item
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Whether the item is completed
+	Completed     bool `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleChecklistItemRequest) Reset() {
+	*x = ToggleChecklistItemRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleChecklistItemRequest) ProtoMessage() {}
+
+func (x *ToggleChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*ToggleChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ToggleChecklistItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToggleChecklistItemRequest) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// Response containing the updated checklist item
+type ToggleChecklistItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated item
+	Item          *ChecklistItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleChecklistItemResponse) Reset() {
+	*x = ToggleChecklistItemResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleChecklistItemResponse) ProtoMessage() {}
+
+func (x *ToggleChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*ToggleChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ToggleChecklistItemResponse) GetItem() *ChecklistItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// Request to reorder a task's checklist
+type ReorderChecklistItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task whose checklist to reorder
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// IDs of every item in the checklist, in the new order
+	ItemIds       []string `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistItemsRequest) Reset() {
+	*x = ReorderChecklistItemsRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+// Response containing the reordered checklist
+type ReorderChecklistItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The checklist items in their new order
+	Items         []*ChecklistItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistItemsResponse) Reset() {
+	*x = ReorderChecklistItemsResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistItemsResponse) ProtoMessage() {}
+
+func (x *ReorderChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReorderChecklistItemsResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Request to delete a checklist item
+type DeleteChecklistItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the checklist item to delete
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteChecklistItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response confirming deletion
+type DeleteChecklistItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success status
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemResponse) Reset() {
+	*x = DeleteChecklistItemResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemResponse) ProtoMessage() {}
+
+func (x *DeleteChecklistItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemResponse.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteChecklistItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request to make a task repeat, or to change the rule of its series
+type SetTaskRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// The repeat rule (required)
+	Rule          *RecurrenceRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaskRecurrenceRequest) Reset() {
+	*x = SetTaskRecurrenceRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskRecurrenceRequest) ProtoMessage() {}
+
+func (x *SetTaskRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SetTaskRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetTaskRecurrenceRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *SetTaskRecurrenceRequest) GetRule() *RecurrenceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Response containing the recurring task
+type SetTaskRecurrenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTaskRecurrenceResponse) Reset() {
+	*x = SetTaskRecurrenceResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTaskRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTaskRecurrenceResponse) ProtoMessage() {}
+
+func (x *SetTaskRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTaskRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SetTaskRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetTaskRecurrenceResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to skip the current occurrence of a recurring task
+type SkipTaskOccurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the open occurrence to skip
+	TaskId        string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipTaskOccurrenceRequest) Reset() {
+	*x = SkipTaskOccurrenceRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipTaskOccurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipTaskOccurrenceRequest) ProtoMessage() {}
+
+func (x *SkipTaskOccurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipTaskOccurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SkipTaskOccurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SkipTaskOccurrenceRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Response containing the rescheduled task
+type SkipTaskOccurrenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The task, moved to its next occurrence
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipTaskOccurrenceResponse) Reset() {
+	*x = SkipTaskOccurrenceResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipTaskOccurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipTaskOccurrenceResponse) ProtoMessage() {}
+
+func (x *SkipTaskOccurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipTaskOccurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SkipTaskOccurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SkipTaskOccurrenceResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to stop a task from repeating
+type StopTaskRecurrenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the open occurrence
+	TaskId        string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTaskRecurrenceRequest) Reset() {
+	*x = StopTaskRecurrenceRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTaskRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskRecurrenceRequest) ProtoMessage() {}
+
+func (x *StopTaskRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*StopTaskRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StopTaskRecurrenceRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// Response containing the task that no longer repeats
+type StopTaskRecurrenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopTaskRecurrenceResponse) Reset() {
+	*x = StopTaskRecurrenceResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopTaskRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopTaskRecurrenceResponse) ProtoMessage() {}
+
+func (x *StopTaskRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopTaskRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*StopTaskRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StopTaskRecurrenceResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// Request to make a task depend on another
+type AddTaskDependencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the dependent task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// ID of the task that must be done first
+	DependsOnId   string `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyRequest) Reset() {
+	*x = AddTaskDependencyRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyRequest) ProtoMessage() {}
+
+func (x *AddTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddTaskDependencyRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddTaskDependencyRequest) GetDependsOnId() string {
+	if x != nil {
+		return x.DependsOnId
+	}
+	return ""
+}
+
+// Response containing the dependent task
+type AddTaskDependencyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated task
+	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyResponse) Reset() {
+	*x = AddTaskDependencyResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyResponse) ProtoMessage() {}
+
+func (x *AddTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use AddTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *AddTaskDependencyResponse) GetTask() *Task {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Task
 	}
 	return nil
 }
 
-// Request to create a new task
-type CreateTaskRequest struct {
+// Request to remove a dependency between two tasks
+type RemoveTaskDependencyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name of the task (required)
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Notes for the task
-	Notes string `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	// Project ID this task belongs to (required)
-	ProjectId     string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// ID of the dependent task
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// ID of the task it no longer depends on
+	DependsOnId   string `protobuf:"bytes,2,opt,name=depends_on_id,json=dependsOnId,proto3" json:"depends_on_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_planner_v1_task_proto_msgTypes[1]
+func (x *RemoveTaskDependencyRequest) Reset() {
+	*x = RemoveTaskDependencyRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *RemoveTaskDependencyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*RemoveTaskDependencyRequest) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[1]
+func (x *RemoveTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,56 +2650,49 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateTaskRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use RemoveTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateTaskRequest) GetNotes() string {
+func (x *RemoveTaskDependencyRequest) GetTaskId() string {
 	if x != nil {
-		return x.Notes
+		return x.TaskId
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetProjectId() string {
+func (x *RemoveTaskDependencyRequest) GetDependsOnId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.DependsOnId
 	}
 	return ""
 }
 
-// Response containing the created task
-type CreateTaskResponse struct {
+// Response containing the dependent task
+type RemoveTaskDependencyResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The created task
+	// The updated task
 	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_planner_v1_task_proto_msgTypes[2]
+func (x *RemoveTaskDependencyResponse) Reset() {
+	*x = RemoveTaskDependencyResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *RemoveTaskDependencyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*RemoveTaskDependencyResponse) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[2]
+func (x *RemoveTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,42 +2703,46 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RemoveTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *RemoveTaskDependencyResponse) GetTask() *Task {
 	if x != nil {
 		return x.Task
 	}
 	return nil
 }
 
-// Request to get a task by ID
-type GetTaskRequest struct {
+// Request to move a task to a new position among its project's tasks, or the Inbox's
+type ReorderTaskRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the task to retrieve
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the task to move
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// ID of the task it should come directly after (empty if only before_id is given)
+	AfterId string `protobuf:"bytes,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	// ID of the task it should come directly before (empty if only after_id is given)
+	BeforeId      string `protobuf:"bytes,3,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_planner_v1_task_proto_msgTypes[3]
+func (x *ReorderTaskRequest) Reset() {
+	*x = ReorderTaskRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *ReorderTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*ReorderTaskRequest) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[3]
+func (x *ReorderTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,42 +2753,56 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ReorderTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReorderTaskRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetTaskRequest) GetId() string {
+func (x *ReorderTaskRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// Response containing the requested task
-type GetTaskResponse struct {
+func (x *ReorderTaskRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *ReorderTaskRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+// Response containing the moved task
+type ReorderTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The requested task
+	// The moved task
 	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_planner_v1_task_proto_msgTypes[4]
+func (x *ReorderTaskResponse) Reset() {
+	*x = ReorderTaskResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *ReorderTaskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*ReorderTaskResponse) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[4]
+func (x *ReorderTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,46 +2813,42 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReorderTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReorderTaskResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetTaskResponse) GetTask() *Task {
+func (x *ReorderTaskResponse) GetTask() *Task {
 	if x != nil {
 		return x.Task
 	}
 	return nil
 }
 
-// Request to list tasks
-type ListTasksRequest struct {
+// Request to create several tasks at once
+type BatchCreateTasksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional project ID to filter tasks
-	ProjectId *string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"`
-	// Optional pagination limit (future use)
-	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	// Optional pagination token (future use)
-	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The tasks to create, applied in order
+	Requests      []*CreateTaskRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_planner_v1_task_proto_msgTypes[5]
+func (x *BatchCreateTasksRequest) Reset() {
+	*x = BatchCreateTasksRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *BatchCreateTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*BatchCreateTasksRequest) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[5]
+func (x *BatchCreateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -354,58 +2859,42 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListTasksRequest) GetProjectId() string {
-	if x != nil && x.ProjectId != nil {
-		return *x.ProjectId
-	}
-	return ""
-}
-
-func (x *ListTasksRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use BatchCreateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ListTasksRequest) GetPageToken() string {
+func (x *BatchCreateTasksRequest) GetRequests() []*CreateTaskRequest {
 	if x != nil {
-		return x.PageToken
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-// Response containing a list of tasks
-type ListTasksResponse struct {
+// Response to a batch create of tasks
+type BatchCreateTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// List of tasks
-	Tasks []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	// Token for next page (future use)
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The created tasks, in request order
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_planner_v1_task_proto_msgTypes[6]
+func (x *BatchCreateTasksResponse) Reset() {
+	*x = BatchCreateTasksResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *BatchCreateTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*BatchCreateTasksResponse) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[6]
+func (x *BatchCreateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,53 +2905,42 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchCreateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListTasksResponse) GetTasks() []*Task {
+func (x *BatchCreateTasksResponse) GetTasks() []*Task {
 	if x != nil {
 		return x.Tasks
 	}
 	return nil
 }
 
-func (x *ListTasksResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-// Request to update an existing task
-type UpdateTaskRequest struct {
+// Request to update several tasks at once
+type BatchUpdateTasksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the task to update
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// New name (if provided)
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// New notes (if provided)
-	Notes         *string `protobuf:"bytes,3,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	// The tasks to update, applied in order
+	Requests      []*UpdateTaskRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_planner_v1_task_proto_msgTypes[7]
+func (x *BatchUpdateTasksRequest) Reset() {
+	*x = BatchUpdateTasksRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *BatchUpdateTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*BatchUpdateTasksRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[7]
+func (x *BatchUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,56 +2951,42 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateTaskRequest) GetId() string {
+func (x *BatchUpdateTasksRequest) GetRequests() []*UpdateTaskRequest {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateTaskRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+		return x.Requests
 	}
-	return ""
-}
-
-func (x *UpdateTaskRequest) GetNotes() string {
-	if x != nil && x.Notes != nil {
-		return *x.Notes
-	}
-	return ""
+	return nil
 }
 
-// Response containing the updated task
-type UpdateTaskResponse struct {
+// Response to a batch update of tasks
+type BatchUpdateTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The updated task
-	Task          *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// The updated tasks, in request order
+	Tasks         []*Task `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_planner_v1_task_proto_msgTypes[8]
+func (x *BatchUpdateTasksResponse) Reset() {
+	*x = BatchUpdateTasksResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *BatchUpdateTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*BatchUpdateTasksResponse) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[8]
+func (x *BatchUpdateTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,42 +2997,42 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BatchUpdateTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTasksResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateTaskResponse) GetTask() *Task {
+func (x *BatchUpdateTasksResponse) GetTasks() []*Task {
 	if x != nil {
-		return x.Task
+		return x.Tasks
 	}
 	return nil
 }
 
-// Request to delete a task
-type DeleteTaskRequest struct {
+// Request to delete several tasks at once
+type BatchDeleteTasksRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID of the task to delete
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The tasks to delete, applied in order
+	Requests      []*DeleteTaskRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_planner_v1_task_proto_msgTypes[9]
+func (x *BatchDeleteTasksRequest) Reset() {
+	*x = BatchDeleteTasksRequest{}
+	mi := &file_planner_v1_task_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *BatchDeleteTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*BatchDeleteTasksRequest) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[9]
+func (x *BatchDeleteTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,42 +3043,42 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BatchDeleteTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTasksRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DeleteTaskRequest) GetId() string {
+func (x *BatchDeleteTasksRequest) GetRequests() []*DeleteTaskRequest {
 	if x != nil {
-		return x.Id
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-// Response confirming deletion
-type DeleteTaskResponse struct {
+// Response to a batch delete of tasks
+type BatchDeleteTasksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Success status
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// The result of each deletion, in request order
+	Results       []*DeleteTaskResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_planner_v1_task_proto_msgTypes[10]
+func (x *BatchDeleteTasksResponse) Reset() {
+	*x = BatchDeleteTasksResponse{}
+	mi := &file_planner_v1_task_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *BatchDeleteTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*BatchDeleteTasksResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_planner_v1_task_proto_msgTypes[10]
+func (x *BatchDeleteTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_task_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,16 +3089,16 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_planner_v1_task_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BatchDeleteTasksResponse.ProtoReflect.Descriptor instead.
+func (*BatchDeleteTasksResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_task_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DeleteTaskResponse) GetSuccess() bool {
+func (x *BatchDeleteTasksResponse) GetResults() []*DeleteTaskResponse {
 	if x != nil {
-		return x.Success
+		return x.Results
 	}
-	return false
+	return nil
 }
 
 var File_planner_v1_task_proto protoreflect.FileDescriptor
@@ -642,7 +3106,15 @@ var File_planner_v1_task_proto protoreflect.FileDescriptor
 const file_planner_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"\x15planner/v1/task.proto\x12\n" +
-	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\"\xd5\x01\n" +
+	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17planner/v1/common.proto\"\x91\x03\n" +
+	"\x0eRecurrenceRule\x12I\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x1f.planner.v1.RecurrenceFrequencyB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\tfrequency\x12&\n" +
+	"\binterval\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x00R\binterval\x12B\n" +
+	"\bweekdays\x18\x03 \x03(\x0e2\x13.planner.v1.WeekdayB\x11\xbaH\x0e\x92\x01\v\x18\x01\"\a\x82\x01\x04\x10\x01 \x00R\bweekdays\x128\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x1a.planner.v1.RecurrenceModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04mode:\x8d\x01\xbaH\x89\x01\x1a\x86\x01\n" +
+	"\x17weekdays_require_weekly\x12:weekdays can only be used with RECURRENCE_FREQUENCY_WEEKLY\x1a/size(this.weekdays) == 0 || this.frequency == 2\"\xc0\x06\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -652,42 +3124,225 @@ const file_planner_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"|\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
+	"\x06status\x18\a \x01(\x0e2\x16.planner.v1.TaskStatusR\x06status\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"start_date\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12\x17\n" +
+	"\atag_ids\x18\v \x03(\tR\x06tagIds\x127\n" +
+	"\tchecklist\x18\f \x03(\v2\x19.planner.v1.ChecklistItemR\tchecklist\x12/\n" +
+	"\x13checklist_completed\x18\r \x01(\x05R\x12checklistCompleted\x12'\n" +
+	"\x0fchecklist_total\x18\x0e \x01(\x05R\x0echecklistTotal\x12\x1b\n" +
+	"\tseries_id\x18\x0f \x01(\tR\bseriesId\x12:\n" +
+	"\n" +
+	"recurrence\x18\x10 \x01(\v2\x1a.planner.v1.RecurrenceRuleR\n" +
+	"recurrence\x12$\n" +
+	"\x0edepends_on_ids\x18\x11 \x03(\tR\fdependsOnIds\x12$\n" +
+	"\x0eblocked_by_ids\x18\x12 \x03(\tR\fblockedByIds\x12\x1d\n" +
+	"\n" +
+	"heading_id\x18\x13 \x01(\tR\theadingId\x12\x18\n" +
+	"\aversion\x18\x14 \x01(\x03R\aversion\"\xe0\x01\n" +
+	"\rChecklistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\bR\tcompleted\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xba\x03\n" +
 	"\x11CreateTaskRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12\x1e\n" +
-	"\x05notes\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x05notes\x12'\n" +
+	"\x05notes\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\x05notes\x12*\n" +
 	"\n" +
-	"project_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\":\n" +
+	"project_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tprojectId\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12*\n" +
+	"\atag_ids\x18\x06 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x12*\n" +
+	"\n" +
+	"heading_id\x18\a \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\theadingId:o\xbaHl\x1aj\n" +
+	"\x18heading_requires_project\x12\x1eheading_id requires project_id\x1a.this.heading_id == '' || this.project_id != ''\":\n" +
 	"\x12CreateTaskResponse\x12$\n" +
 	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"*\n" +
 	"\x0eGetTaskRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"7\n" +
 	"\x0fGetTaskResponse\x12$\n" +
-	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"\x8b\x01\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"\xf9\x06\n" +
 	"\x10ListTasksRequest\x12,\n" +
 	"\n" +
-	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tprojectId\x88\x01\x01\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"project_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\tprojectId\x88\x01\x01\x12$\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x03 \x01(\tR\tpageTokenB\r\n" +
-	"\v_project_id\"c\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12=\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x16.planner.v1.TaskStatusB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\x06status\x88\x01\x01\x12C\n" +
+	"\x0fcompleted_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0ecompletedAfter\x12E\n" +
+	"\x10completed_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fcompletedBefore\x127\n" +
+	"\tdue_after\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdueAfter\x129\n" +
+	"\n" +
+	"due_before\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdueBefore\x12#\n" +
+	"\ravailable_now\x18\t \x01(\bR\favailableNow\x12\x14\n" +
+	"\x05inbox\x18\n" +
+	" \x01(\bR\x05inbox\x12*\n" +
+	"\atag_ids\x18\v \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x12;\n" +
+	"\ttag_match\x18\f \x01(\x0e2\x14.planner.v1.TagMatchB\b\xbaH\x05\x82\x01\x02\x10\x01R\btagMatch\x12%\n" +
+	"\x0eonly_unblocked\x18\r \x01(\bR\ronlyUnblocked\x125\n" +
+	"\x05order\x18\x0e \x01(\x0e2\x15.planner.v1.ListOrderB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05order\x12)\n" +
+	"\x10include_archived\x18\x0f \x01(\bR\x0fincludeArchived:l\xbaHi\x1ag\n" +
+	"\x15inbox_without_project\x12(inbox cannot be combined with project_id\x1a$!this.inbox || !has(this.project_id)B\r\n" +
+	"\v_project_idB\t\n" +
+	"\a_status\"c\n" +
 	"\x11ListTasksResponse\x12&\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x10.planner.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8b\x06\n" +
 	"\x11UpdateTaskRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12#\n" +
-	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x01R\x05notes\x88\x01\x01B\a\n" +
+	"\x05notes\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NH\x01R\x05notes\x88\x01\x01\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x12$\n" +
+	"\x0eclear_due_date\x18\x06 \x01(\bR\fclearDueDate\x12(\n" +
+	"\x10clear_start_date\x18\a \x01(\bR\x0eclearStartDate\x12,\n" +
+	"\n" +
+	"project_id\x18\b \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x02R\tprojectId\x88\x01\x01\x12#\n" +
+	"\rclear_project\x18\t \x01(\bR\fclearProject\x12\x19\n" +
+	"\bset_tags\x18\n" +
+	" \x01(\bR\asetTags\x12*\n" +
+	"\atag_ids\x18\v \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10d\x18\x01\"\x05r\x03\xb0\x01\x01R\x06tagIds\x12,\n" +
+	"\n" +
+	"heading_id\x18\f \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\theadingId\x88\x01\x01\x12#\n" +
+	"\rclear_heading\x18\r \x01(\bR\fclearHeading\x127\n" +
+	"\x10expected_version\x18\x0e \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x04R\x0fexpectedVersion\x88\x01\x01:d\xbaHa\x1a_\n" +
+	"\x18tag_ids_require_set_tags\x12\x19tag_ids requires set_tags\x1a(this.set_tags || size(this.tag_ids) == 0B\a\n" +
 	"\x05_nameB\b\n" +
-	"\x06_notes\":\n" +
+	"\x06_notesB\r\n" +
+	"\v_project_idB\r\n" +
+	"\v_heading_idB\x13\n" +
+	"\x11_expected_version\":\n" +
 	"\x12UpdateTaskResponse\x12$\n" +
-	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"-\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"{\n" +
 	"\x11DeleteTaskRequest\x12\x18\n" +
-	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\".\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x127\n" +
+	"\x10expected_version\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\".\n" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x82\x03\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x13CompleteTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x16\n" +
+	"\x06cancel\x18\x02 \x01(\bR\x06cancel\"k\n" +
+	"\x14CompleteTaskResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\x12-\n" +
+	"\tnext_task\x18\x02 \x01(\v2\x10.planner.v1.TaskR\bnextTask\"-\n" +
+	"\x11ReopenTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\":\n" +
+	"\x12ReopenTaskResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"T\n" +
+	"\x0fFileTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12'\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprojectId\"8\n" +
+	"\x10FileTaskResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"o\n" +
+	"\x10MoveTasksRequest\x12/\n" +
+	"\btask_ids\x18\x01 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xe8\a\x18\x01\"\x05r\x03\xb0\x01\x01R\ataskIds\x12*\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\tprojectId\";\n" +
+	"\x11MoveTasksResponse\x12&\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x10.planner.v1.TaskR\x05tasks\"_\n" +
+	"\x1aCreateChecklistItemRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\"L\n" +
+	"\x1bCreateChecklistItemResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.planner.v1.ChecklistItemR\x04item\"T\n" +
+	"\x1aToggleChecklistItemRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\"L\n" +
+	"\x1bToggleChecklistItemResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.planner.v1.ChecklistItemR\x04item\"r\n" +
+	"\x1cReorderChecklistItemsRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12/\n" +
+	"\bitem_ids\x18\x02 \x03(\tB\x14\xbaH\x11\x92\x01\x0e\b\x01\x10\xe8\a\x18\x01\"\x05r\x03\xb0\x01\x01R\aitemIds\"P\n" +
+	"\x1dReorderChecklistItemsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.planner.v1.ChecklistItemR\x05items\"6\n" +
+	"\x1aDeleteChecklistItemRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"7\n" +
+	"\x1bDeleteChecklistItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"u\n" +
+	"\x18SetTaskRecurrenceRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x126\n" +
+	"\x04rule\x18\x02 \x01(\v2\x1a.planner.v1.RecurrenceRuleB\x06\xbaH\x03\xc8\x01\x01R\x04rule\"A\n" +
+	"\x19SetTaskRecurrenceResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\">\n" +
+	"\x19SkipTaskOccurrenceRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"B\n" +
+	"\x1aSkipTaskOccurrenceResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\">\n" +
+	"\x19StopTaskRecurrenceRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"B\n" +
+	"\x1aStopTaskRecurrenceResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"\xca\x01\n" +
+	"\x18AddTaskDependencyRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12,\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdependsOnId:]\xbaHZ\x1aX\n" +
+	"\x12no_self_dependency\x12\x1ea task cannot depend on itself\x1a\"this.task_id != this.depends_on_id\"A\n" +
+	"\x19AddTaskDependencyResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"n\n" +
+	"\x1bRemoveTaskDependencyRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12,\n" +
+	"\rdepends_on_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vdependsOnId\"D\n" +
+	"\x1cRemoveTaskDependencyResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"\xf7\x02\n" +
+	"\x12ReorderTaskRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12&\n" +
+	"\bafter_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\aafterId\x12(\n" +
+	"\tbefore_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\bbeforeId:\xf4\x01\xbaH\xf0\x01\x1au\n" +
+	"\x12neighbour_required\x122at least one of after_id and before_id is required\x1a+this.after_id != '' || this.before_id != ''\x1aw\n" +
+	"\x12neighbour_not_self\x12*after_id and before_id must differ from id\x1a5this.after_id != this.id && this.before_id != this.id\";\n" +
+	"\x13ReorderTaskResponse\x12$\n" +
+	"\x04task\x18\x01 \x01(\v2\x10.planner.v1.TaskR\x04task\"a\n" +
+	"\x17BatchCreateTasksRequest\x12F\n" +
+	"\brequests\x18\x01 \x03(\v2\x1d.planner.v1.CreateTaskRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"B\n" +
+	"\x18BatchCreateTasksResponse\x12&\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x10.planner.v1.TaskR\x05tasks\"a\n" +
+	"\x17BatchUpdateTasksRequest\x12F\n" +
+	"\brequests\x18\x01 \x03(\v2\x1d.planner.v1.UpdateTaskRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"B\n" +
+	"\x18BatchUpdateTasksResponse\x12&\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x10.planner.v1.TaskR\x05tasks\"a\n" +
+	"\x17BatchDeleteTasksRequest\x12F\n" +
+	"\brequests\x18\x01 \x03(\v2\x1d.planner.v1.DeleteTaskRequestB\v\xbaH\b\x92\x01\x05\b\x01\x10\xe8\aR\brequests\"T\n" +
+	"\x18BatchDeleteTasksResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.planner.v1.DeleteTaskResponseR\aresults*u\n" +
+	"\n" +
+	"TaskStatus\x12\x1b\n" +
+	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10TASK_STATUS_OPEN\x10\x01\x12\x19\n" +
+	"\x15TASK_STATUS_COMPLETED\x10\x02\x12\x19\n" +
+	"\x15TASK_STATUS_CANCELLED\x10\x03*\xbf\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x02\x12 \n" +
+	"\x1cRECURRENCE_FREQUENCY_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_YEARLY\x10\x04*{\n" +
+	"\x0eRecurrenceMode\x12\x1f\n" +
+	"\x1bRECURRENCE_MODE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eRECURRENCE_MODE_FIXED_SCHEDULE\x10\x01\x12$\n" +
+	" RECURRENCE_MODE_AFTER_COMPLETION\x10\x02*\xb6\x01\n" +
+	"\aWeekday\x12\x17\n" +
+	"\x13WEEKDAY_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eWEEKDAY_MONDAY\x10\x01\x12\x13\n" +
+	"\x0fWEEKDAY_TUESDAY\x10\x02\x12\x15\n" +
+	"\x11WEEKDAY_WEDNESDAY\x10\x03\x12\x14\n" +
+	"\x10WEEKDAY_THURSDAY\x10\x04\x12\x12\n" +
+	"\x0eWEEKDAY_FRIDAY\x10\x05\x12\x14\n" +
+	"\x10WEEKDAY_SATURDAY\x10\x06\x12\x12\n" +
+	"\x0eWEEKDAY_SUNDAY\x10\a2\xbf\x0f\n" +
 	"\vTaskService\x12K\n" +
 	"\n" +
 	"CreateTask\x12\x1d.planner.v1.CreateTaskRequest\x1a\x1e.planner.v1.CreateTaskResponse\x12B\n" +
@@ -696,7 +3351,25 @@ const file_planner_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTask\x12\x1d.planner.v1.UpdateTaskRequest\x1a\x1e.planner.v1.UpdateTaskResponse\x12K\n" +
 	"\n" +
-	"DeleteTask\x12\x1d.planner.v1.DeleteTaskRequest\x1a\x1e.planner.v1.DeleteTaskResponseB\xa4\x01\n" +
+	"DeleteTask\x12\x1d.planner.v1.DeleteTaskRequest\x1a\x1e.planner.v1.DeleteTaskResponse\x12Q\n" +
+	"\fCompleteTask\x12\x1f.planner.v1.CompleteTaskRequest\x1a .planner.v1.CompleteTaskResponse\x12K\n" +
+	"\n" +
+	"ReopenTask\x12\x1d.planner.v1.ReopenTaskRequest\x1a\x1e.planner.v1.ReopenTaskResponse\x12E\n" +
+	"\bFileTask\x12\x1b.planner.v1.FileTaskRequest\x1a\x1c.planner.v1.FileTaskResponse\x12H\n" +
+	"\tMoveTasks\x12\x1c.planner.v1.MoveTasksRequest\x1a\x1d.planner.v1.MoveTasksResponse\x12f\n" +
+	"\x13CreateChecklistItem\x12&.planner.v1.CreateChecklistItemRequest\x1a'.planner.v1.CreateChecklistItemResponse\x12f\n" +
+	"\x13ToggleChecklistItem\x12&.planner.v1.ToggleChecklistItemRequest\x1a'.planner.v1.ToggleChecklistItemResponse\x12l\n" +
+	"\x15ReorderChecklistItems\x12(.planner.v1.ReorderChecklistItemsRequest\x1a).planner.v1.ReorderChecklistItemsResponse\x12f\n" +
+	"\x13DeleteChecklistItem\x12&.planner.v1.DeleteChecklistItemRequest\x1a'.planner.v1.DeleteChecklistItemResponse\x12`\n" +
+	"\x11SetTaskRecurrence\x12$.planner.v1.SetTaskRecurrenceRequest\x1a%.planner.v1.SetTaskRecurrenceResponse\x12c\n" +
+	"\x12SkipTaskOccurrence\x12%.planner.v1.SkipTaskOccurrenceRequest\x1a&.planner.v1.SkipTaskOccurrenceResponse\x12c\n" +
+	"\x12StopTaskRecurrence\x12%.planner.v1.StopTaskRecurrenceRequest\x1a&.planner.v1.StopTaskRecurrenceResponse\x12`\n" +
+	"\x11AddTaskDependency\x12$.planner.v1.AddTaskDependencyRequest\x1a%.planner.v1.AddTaskDependencyResponse\x12i\n" +
+	"\x14RemoveTaskDependency\x12'.planner.v1.RemoveTaskDependencyRequest\x1a(.planner.v1.RemoveTaskDependencyResponse\x12N\n" +
+	"\vReorderTask\x12\x1e.planner.v1.ReorderTaskRequest\x1a\x1f.planner.v1.ReorderTaskResponse\x12]\n" +
+	"\x10BatchCreateTasks\x12#.planner.v1.BatchCreateTasksRequest\x1a$.planner.v1.BatchCreateTasksResponse\x12]\n" +
+	"\x10BatchUpdateTasks\x12#.planner.v1.BatchUpdateTasksRequest\x1a$.planner.v1.BatchUpdateTasksResponse\x12]\n" +
+	"\x10BatchDeleteTasks\x12#.planner.v1.BatchDeleteTasksRequest\x1a$.planner.v1.BatchDeleteTasksResponseB\xa4\x01\n" +
 	"\x0ecom.planner.v1B\tTaskProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Planner.V1\xca\x02\n" +
 	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
@@ -713,43 +3386,163 @@ func file_planner_v1_task_proto_rawDescGZIP() []byte {
 	return file_planner_v1_task_proto_rawDescData
 }
 
-var file_planner_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_planner_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_planner_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_planner_v1_task_proto_goTypes = []any{
-	(*Task)(nil),                  // 0: planner.v1.Task
-	(*CreateTaskRequest)(nil),     // 1: planner.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),    // 2: planner.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),        // 3: planner.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),       // 4: planner.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),      // 5: planner.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),     // 6: planner.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),     // 7: planner.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),    // 8: planner.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),     // 9: planner.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),    // 10: planner.v1.DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(TaskStatus)(0),                       // 0: planner.v1.TaskStatus
+	(RecurrenceFrequency)(0),              // 1: planner.v1.RecurrenceFrequency
+	(RecurrenceMode)(0),                   // 2: planner.v1.RecurrenceMode
+	(Weekday)(0),                          // 3: planner.v1.Weekday
+	(*RecurrenceRule)(nil),                // 4: planner.v1.RecurrenceRule
+	(*Task)(nil),                          // 5: planner.v1.Task
+	(*ChecklistItem)(nil),                 // 6: planner.v1.ChecklistItem
+	(*CreateTaskRequest)(nil),             // 7: planner.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),            // 8: planner.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                // 9: planner.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),               // 10: planner.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),              // 11: planner.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),             // 12: planner.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),             // 13: planner.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),            // 14: planner.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),             // 15: planner.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),            // 16: planner.v1.DeleteTaskResponse
+	(*CompleteTaskRequest)(nil),           // 17: planner.v1.CompleteTaskRequest
+	(*CompleteTaskResponse)(nil),          // 18: planner.v1.CompleteTaskResponse
+	(*ReopenTaskRequest)(nil),             // 19: planner.v1.ReopenTaskRequest
+	(*ReopenTaskResponse)(nil),            // 20: planner.v1.ReopenTaskResponse
+	(*FileTaskRequest)(nil),               // 21: planner.v1.FileTaskRequest
+	(*FileTaskResponse)(nil),              // 22: planner.v1.FileTaskResponse
+	(*MoveTasksRequest)(nil),              // 23: planner.v1.MoveTasksRequest
+	(*MoveTasksResponse)(nil),             // 24: planner.v1.MoveTasksResponse
+	(*CreateChecklistItemRequest)(nil),    // 25: planner.v1.CreateChecklistItemRequest
+	(*CreateChecklistItemResponse)(nil),   // 26: planner.v1.CreateChecklistItemResponse
+	(*ToggleChecklistItemRequest)(nil),    // 27: planner.v1.ToggleChecklistItemRequest
+	(*ToggleChecklistItemResponse)(nil),   // 28: planner.v1.ToggleChecklistItemResponse
+	(*ReorderChecklistItemsRequest)(nil),  // 29: planner.v1.ReorderChecklistItemsRequest
+	(*ReorderChecklistItemsResponse)(nil), // 30: planner.v1.ReorderChecklistItemsResponse
+	(*DeleteChecklistItemRequest)(nil),    // 31: planner.v1.DeleteChecklistItemRequest
+	(*DeleteChecklistItemResponse)(nil),   // 32: planner.v1.DeleteChecklistItemResponse
+	(*SetTaskRecurrenceRequest)(nil),      // 33: planner.v1.SetTaskRecurrenceRequest
+	(*SetTaskRecurrenceResponse)(nil),     // 34: planner.v1.SetTaskRecurrenceResponse
+	(*SkipTaskOccurrenceRequest)(nil),     // 35: planner.v1.SkipTaskOccurrenceRequest
+	(*SkipTaskOccurrenceResponse)(nil),    // 36: planner.v1.SkipTaskOccurrenceResponse
+	(*StopTaskRecurrenceRequest)(nil),     // 37: planner.v1.StopTaskRecurrenceRequest
+	(*StopTaskRecurrenceResponse)(nil),    // 38: planner.v1.StopTaskRecurrenceResponse
+	(*AddTaskDependencyRequest)(nil),      // 39: planner.v1.AddTaskDependencyRequest
+	(*AddTaskDependencyResponse)(nil),     // 40: planner.v1.AddTaskDependencyResponse
+	(*RemoveTaskDependencyRequest)(nil),   // 41: planner.v1.RemoveTaskDependencyRequest
+	(*RemoveTaskDependencyResponse)(nil),  // 42: planner.v1.RemoveTaskDependencyResponse
+	(*ReorderTaskRequest)(nil),            // 43: planner.v1.ReorderTaskRequest
+	(*ReorderTaskResponse)(nil),           // 44: planner.v1.ReorderTaskResponse
+	(*BatchCreateTasksRequest)(nil),       // 45: planner.v1.BatchCreateTasksRequest
+	(*BatchCreateTasksResponse)(nil),      // 46: planner.v1.BatchCreateTasksResponse
+	(*BatchUpdateTasksRequest)(nil),       // 47: planner.v1.BatchUpdateTasksRequest
+	(*BatchUpdateTasksResponse)(nil),      // 48: planner.v1.BatchUpdateTasksResponse
+	(*BatchDeleteTasksRequest)(nil),       // 49: planner.v1.BatchDeleteTasksRequest
+	(*BatchDeleteTasksResponse)(nil),      // 50: planner.v1.BatchDeleteTasksResponse
+	(*timestamppb.Timestamp)(nil),         // 51: google.protobuf.Timestamp
+	(TagMatch)(0),                         // 52: planner.v1.TagMatch
+	(ListOrder)(0),                        // 53: planner.v1.ListOrder
 }
 var file_planner_v1_task_proto_depIdxs = []int32{
-	11, // 0: planner.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	11, // 1: planner.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: planner.v1.CreateTaskResponse.task:type_name -> planner.v1.Task
-	0,  // 3: planner.v1.GetTaskResponse.task:type_name -> planner.v1.Task
-	0,  // 4: planner.v1.ListTasksResponse.tasks:type_name -> planner.v1.Task
-	0,  // 5: planner.v1.UpdateTaskResponse.task:type_name -> planner.v1.Task
-	1,  // 6: planner.v1.TaskService.CreateTask:input_type -> planner.v1.CreateTaskRequest
-	3,  // 7: planner.v1.TaskService.GetTask:input_type -> planner.v1.GetTaskRequest
-	5,  // 8: planner.v1.TaskService.ListTasks:input_type -> planner.v1.ListTasksRequest
-	7,  // 9: planner.v1.TaskService.UpdateTask:input_type -> planner.v1.UpdateTaskRequest
-	9,  // 10: planner.v1.TaskService.DeleteTask:input_type -> planner.v1.DeleteTaskRequest
-	2,  // 11: planner.v1.TaskService.CreateTask:output_type -> planner.v1.CreateTaskResponse
-	4,  // 12: planner.v1.TaskService.GetTask:output_type -> planner.v1.GetTaskResponse
-	6,  // 13: planner.v1.TaskService.ListTasks:output_type -> planner.v1.ListTasksResponse
-	8,  // 14: planner.v1.TaskService.UpdateTask:output_type -> planner.v1.UpdateTaskResponse
-	10, // 15: planner.v1.TaskService.DeleteTask:output_type -> planner.v1.DeleteTaskResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	1,  // 0: planner.v1.RecurrenceRule.frequency:type_name -> planner.v1.RecurrenceFrequency
+	3,  // 1: planner.v1.RecurrenceRule.weekdays:type_name -> planner.v1.Weekday
+	2,  // 2: planner.v1.RecurrenceRule.mode:type_name -> planner.v1.RecurrenceMode
+	51, // 3: planner.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	51, // 4: planner.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: planner.v1.Task.status:type_name -> planner.v1.TaskStatus
+	51, // 6: planner.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	51, // 7: planner.v1.Task.due_date:type_name -> google.protobuf.Timestamp
+	51, // 8: planner.v1.Task.start_date:type_name -> google.protobuf.Timestamp
+	6,  // 9: planner.v1.Task.checklist:type_name -> planner.v1.ChecklistItem
+	4,  // 10: planner.v1.Task.recurrence:type_name -> planner.v1.RecurrenceRule
+	51, // 11: planner.v1.ChecklistItem.created_at:type_name -> google.protobuf.Timestamp
+	51, // 12: planner.v1.ChecklistItem.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 13: planner.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	51, // 14: planner.v1.CreateTaskRequest.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 15: planner.v1.CreateTaskResponse.task:type_name -> planner.v1.Task
+	5,  // 16: planner.v1.GetTaskResponse.task:type_name -> planner.v1.Task
+	0,  // 17: planner.v1.ListTasksRequest.status:type_name -> planner.v1.TaskStatus
+	51, // 18: planner.v1.ListTasksRequest.completed_after:type_name -> google.protobuf.Timestamp
+	51, // 19: planner.v1.ListTasksRequest.completed_before:type_name -> google.protobuf.Timestamp
+	51, // 20: planner.v1.ListTasksRequest.due_after:type_name -> google.protobuf.Timestamp
+	51, // 21: planner.v1.ListTasksRequest.due_before:type_name -> google.protobuf.Timestamp
+	52, // 22: planner.v1.ListTasksRequest.tag_match:type_name -> planner.v1.TagMatch
+	53, // 23: planner.v1.ListTasksRequest.order:type_name -> planner.v1.ListOrder
+	5,  // 24: planner.v1.ListTasksResponse.tasks:type_name -> planner.v1.Task
+	51, // 25: planner.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	51, // 26: planner.v1.UpdateTaskRequest.start_date:type_name -> google.protobuf.Timestamp
+	5,  // 27: planner.v1.UpdateTaskResponse.task:type_name -> planner.v1.Task
+	5,  // 28: planner.v1.CompleteTaskResponse.task:type_name -> planner.v1.Task
+	5,  // 29: planner.v1.CompleteTaskResponse.next_task:type_name -> planner.v1.Task
+	5,  // 30: planner.v1.ReopenTaskResponse.task:type_name -> planner.v1.Task
+	5,  // 31: planner.v1.FileTaskResponse.task:type_name -> planner.v1.Task
+	5,  // 32: planner.v1.MoveTasksResponse.tasks:type_name -> planner.v1.Task
+	6,  // 33: planner.v1.CreateChecklistItemResponse.item:type_name -> planner.v1.ChecklistItem
+	6,  // 34: planner.v1.ToggleChecklistItemResponse.item:type_name -> planner.v1.ChecklistItem
+	6,  // 35: planner.v1.ReorderChecklistItemsResponse.items:type_name -> planner.v1.ChecklistItem
+	4,  // 36: planner.v1.SetTaskRecurrenceRequest.rule:type_name -> planner.v1.RecurrenceRule
+	5,  // 37: planner.v1.SetTaskRecurrenceResponse.task:type_name -> planner.v1.Task
+	5,  // 38: planner.v1.SkipTaskOccurrenceResponse.task:type_name -> planner.v1.Task
+	5,  // 39: planner.v1.StopTaskRecurrenceResponse.task:type_name -> planner.v1.Task
+	5,  // 40: planner.v1.AddTaskDependencyResponse.task:type_name -> planner.v1.Task
+	5,  // 41: planner.v1.RemoveTaskDependencyResponse.task:type_name -> planner.v1.Task
+	5,  // 42: planner.v1.ReorderTaskResponse.task:type_name -> planner.v1.Task
+	7,  // 43: planner.v1.BatchCreateTasksRequest.requests:type_name -> planner.v1.CreateTaskRequest
+	5,  // 44: planner.v1.BatchCreateTasksResponse.tasks:type_name -> planner.v1.Task
+	13, // 45: planner.v1.BatchUpdateTasksRequest.requests:type_name -> planner.v1.UpdateTaskRequest
+	5,  // 46: planner.v1.BatchUpdateTasksResponse.tasks:type_name -> planner.v1.Task
+	15, // 47: planner.v1.BatchDeleteTasksRequest.requests:type_name -> planner.v1.DeleteTaskRequest
+	16, // 48: planner.v1.BatchDeleteTasksResponse.results:type_name -> planner.v1.DeleteTaskResponse
+	7,  // 49: planner.v1.TaskService.CreateTask:input_type -> planner.v1.CreateTaskRequest
+	9,  // 50: planner.v1.TaskService.GetTask:input_type -> planner.v1.GetTaskRequest
+	11, // 51: planner.v1.TaskService.ListTasks:input_type -> planner.v1.ListTasksRequest
+	13, // 52: planner.v1.TaskService.UpdateTask:input_type -> planner.v1.UpdateTaskRequest
+	15, // 53: planner.v1.TaskService.DeleteTask:input_type -> planner.v1.DeleteTaskRequest
+	17, // 54: planner.v1.TaskService.CompleteTask:input_type -> planner.v1.CompleteTaskRequest
+	19, // 55: planner.v1.TaskService.ReopenTask:input_type -> planner.v1.ReopenTaskRequest
+	21, // 56: planner.v1.TaskService.FileTask:input_type -> planner.v1.FileTaskRequest
+	23, // 57: planner.v1.TaskService.MoveTasks:input_type -> planner.v1.MoveTasksRequest
+	25, // 58: planner.v1.TaskService.CreateChecklistItem:input_type -> planner.v1.CreateChecklistItemRequest
+	27, // 59: planner.v1.TaskService.ToggleChecklistItem:input_type -> planner.v1.ToggleChecklistItemRequest
+	29, // 60: planner.v1.TaskService.ReorderChecklistItems:input_type -> planner.v1.ReorderChecklistItemsRequest
+	31, // 61: planner.v1.TaskService.DeleteChecklistItem:input_type -> planner.v1.DeleteChecklistItemRequest
+	33, // 62: planner.v1.TaskService.SetTaskRecurrence:input_type -> planner.v1.SetTaskRecurrenceRequest
+	35, // 63: planner.v1.TaskService.SkipTaskOccurrence:input_type -> planner.v1.SkipTaskOccurrenceRequest
+	37, // 64: planner.v1.TaskService.StopTaskRecurrence:input_type -> planner.v1.StopTaskRecurrenceRequest
+	39, // 65: planner.v1.TaskService.AddTaskDependency:input_type -> planner.v1.AddTaskDependencyRequest
+	41, // 66: planner.v1.TaskService.RemoveTaskDependency:input_type -> planner.v1.RemoveTaskDependencyRequest
+	43, // 67: planner.v1.TaskService.ReorderTask:input_type -> planner.v1.ReorderTaskRequest
+	45, // 68: planner.v1.TaskService.BatchCreateTasks:input_type -> planner.v1.BatchCreateTasksRequest
+	47, // 69: planner.v1.TaskService.BatchUpdateTasks:input_type -> planner.v1.BatchUpdateTasksRequest
+	49, // 70: planner.v1.TaskService.BatchDeleteTasks:input_type -> planner.v1.BatchDeleteTasksRequest
+	8,  // 71: planner.v1.TaskService.CreateTask:output_type -> planner.v1.CreateTaskResponse
+	10, // 72: planner.v1.TaskService.GetTask:output_type -> planner.v1.GetTaskResponse
+	12, // 73: planner.v1.TaskService.ListTasks:output_type -> planner.v1.ListTasksResponse
+	14, // 74: planner.v1.TaskService.UpdateTask:output_type -> planner.v1.UpdateTaskResponse
+	16, // 75: planner.v1.TaskService.DeleteTask:output_type -> planner.v1.DeleteTaskResponse
+	18, // 76: planner.v1.TaskService.CompleteTask:output_type -> planner.v1.CompleteTaskResponse
+	20, // 77: planner.v1.TaskService.ReopenTask:output_type -> planner.v1.ReopenTaskResponse
+	22, // 78: planner.v1.TaskService.FileTask:output_type -> planner.v1.FileTaskResponse
+	24, // 79: planner.v1.TaskService.MoveTasks:output_type -> planner.v1.MoveTasksResponse
+	26, // 80: planner.v1.TaskService.CreateChecklistItem:output_type -> planner.v1.CreateChecklistItemResponse
+	28, // 81: planner.v1.TaskService.ToggleChecklistItem:output_type -> planner.v1.ToggleChecklistItemResponse
+	30, // 82: planner.v1.TaskService.ReorderChecklistItems:output_type -> planner.v1.ReorderChecklistItemsResponse
+	32, // 83: planner.v1.TaskService.DeleteChecklistItem:output_type -> planner.v1.DeleteChecklistItemResponse
+	34, // 84: planner.v1.TaskService.SetTaskRecurrence:output_type -> planner.v1.SetTaskRecurrenceResponse
+	36, // 85: planner.v1.TaskService.SkipTaskOccurrence:output_type -> planner.v1.SkipTaskOccurrenceResponse
+	38, // 86: planner.v1.TaskService.StopTaskRecurrence:output_type -> planner.v1.StopTaskRecurrenceResponse
+	40, // 87: planner.v1.TaskService.AddTaskDependency:output_type -> planner.v1.AddTaskDependencyResponse
+	42, // 88: planner.v1.TaskService.RemoveTaskDependency:output_type -> planner.v1.RemoveTaskDependencyResponse
+	44, // 89: planner.v1.TaskService.ReorderTask:output_type -> planner.v1.ReorderTaskResponse
+	46, // 90: planner.v1.TaskService.BatchCreateTasks:output_type -> planner.v1.BatchCreateTasksResponse
+	48, // 91: planner.v1.TaskService.BatchUpdateTasks:output_type -> planner.v1.BatchUpdateTasksResponse
+	50, // 92: planner.v1.TaskService.BatchDeleteTasks:output_type -> planner.v1.BatchDeleteTasksResponse
+	71, // [71:93] is the sub-list for method output_type
+	49, // [49:71] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_planner_v1_task_proto_init() }
@@ -757,20 +3550,23 @@ func file_planner_v1_task_proto_init() {
 	if File_planner_v1_task_proto != nil {
 		return
 	}
-	file_planner_v1_task_proto_msgTypes[5].OneofWrappers = []any{}
+	file_planner_v1_common_proto_init()
 	file_planner_v1_task_proto_msgTypes[7].OneofWrappers = []any{}
+	file_planner_v1_task_proto_msgTypes[9].OneofWrappers = []any{}
+	file_planner_v1_task_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_task_proto_rawDesc), len(file_planner_v1_task_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_planner_v1_task_proto_goTypes,
 		DependencyIndexes: file_planner_v1_task_proto_depIdxs,
+		EnumInfos:         file_planner_v1_task_proto_enumTypes,
 		MessageInfos:      file_planner_v1_task_proto_msgTypes,
 	}.Build()
 	File_planner_v1_task_proto = out.File
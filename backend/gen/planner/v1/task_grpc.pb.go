@@ -19,11 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_CreateTask_FullMethodName = "/planner.v1.TaskService/CreateTask"
-	TaskService_GetTask_FullMethodName    = "/planner.v1.TaskService/GetTask"
-	TaskService_ListTasks_FullMethodName  = "/planner.v1.TaskService/ListTasks"
-	TaskService_UpdateTask_FullMethodName = "/planner.v1.TaskService/UpdateTask"
-	TaskService_DeleteTask_FullMethodName = "/planner.v1.TaskService/DeleteTask"
+	TaskService_CreateTask_FullMethodName            = "/planner.v1.TaskService/CreateTask"
+	TaskService_GetTask_FullMethodName               = "/planner.v1.TaskService/GetTask"
+	TaskService_ListTasks_FullMethodName             = "/planner.v1.TaskService/ListTasks"
+	TaskService_UpdateTask_FullMethodName            = "/planner.v1.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName            = "/planner.v1.TaskService/DeleteTask"
+	TaskService_CompleteTask_FullMethodName          = "/planner.v1.TaskService/CompleteTask"
+	TaskService_ReopenTask_FullMethodName            = "/planner.v1.TaskService/ReopenTask"
+	TaskService_FileTask_FullMethodName              = "/planner.v1.TaskService/FileTask"
+	TaskService_MoveTasks_FullMethodName             = "/planner.v1.TaskService/MoveTasks"
+	TaskService_CreateChecklistItem_FullMethodName   = "/planner.v1.TaskService/CreateChecklistItem"
+	TaskService_ToggleChecklistItem_FullMethodName   = "/planner.v1.TaskService/ToggleChecklistItem"
+	TaskService_ReorderChecklistItems_FullMethodName = "/planner.v1.TaskService/ReorderChecklistItems"
+	TaskService_DeleteChecklistItem_FullMethodName   = "/planner.v1.TaskService/DeleteChecklistItem"
+	TaskService_SetTaskRecurrence_FullMethodName     = "/planner.v1.TaskService/SetTaskRecurrence"
+	TaskService_SkipTaskOccurrence_FullMethodName    = "/planner.v1.TaskService/SkipTaskOccurrence"
+	TaskService_StopTaskRecurrence_FullMethodName    = "/planner.v1.TaskService/StopTaskRecurrence"
+	TaskService_AddTaskDependency_FullMethodName     = "/planner.v1.TaskService/AddTaskDependency"
+	TaskService_RemoveTaskDependency_FullMethodName  = "/planner.v1.TaskService/RemoveTaskDependency"
+	TaskService_ReorderTask_FullMethodName           = "/planner.v1.TaskService/ReorderTask"
+	TaskService_BatchCreateTasks_FullMethodName      = "/planner.v1.TaskService/BatchCreateTasks"
+	TaskService_BatchUpdateTasks_FullMethodName      = "/planner.v1.TaskService/BatchUpdateTasks"
+	TaskService_BatchDeleteTasks_FullMethodName      = "/planner.v1.TaskService/BatchDeleteTasks"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -36,12 +53,50 @@ type TaskServiceClient interface {
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*CreateTaskResponse, error)
 	// Get a task by ID
 	GetTask(ctx context.Context, in *GetTaskRequest, opts ...grpc.CallOption) (*GetTaskResponse, error)
-	// List tasks (optionally filtered by project)
+	// List tasks (optionally filtered by project or restricted to the Inbox)
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	// Update an existing task
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*UpdateTaskResponse, error)
-	// Delete a task
+	// Move a task to the trash
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	// Mark a task as completed or cancelled; completing a recurring task creates its next occurrence.
+	// A task already in the requested state is returned unchanged; one in the
+	// other finished state fails with FAILED_PRECONDITION until it is reopened.
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error)
+	// Reopen a completed or cancelled task
+	ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*ReopenTaskResponse, error)
+	// File a task (typically from the Inbox) into a project
+	FileTask(ctx context.Context, in *FileTaskRequest, opts ...grpc.CallOption) (*FileTaskResponse, error)
+	// Move several tasks to a project (or the Inbox) in a single transaction
+	MoveTasks(ctx context.Context, in *MoveTasksRequest, opts ...grpc.CallOption) (*MoveTasksResponse, error)
+	// Add an item to the end of a task's checklist
+	CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CreateChecklistItemResponse, error)
+	// Tick off or untick a checklist item
+	ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*ToggleChecklistItemResponse, error)
+	// Reorder all items of a task's checklist
+	ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*ReorderChecklistItemsResponse, error)
+	// Delete a checklist item
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error)
+	// Make a task repeat, or change the rule for all future occurrences of its series
+	SetTaskRecurrence(ctx context.Context, in *SetTaskRecurrenceRequest, opts ...grpc.CallOption) (*SetTaskRecurrenceResponse, error)
+	// Skip the current occurrence of a recurring task, moving it to the next date
+	SkipTaskOccurrence(ctx context.Context, in *SkipTaskOccurrenceRequest, opts ...grpc.CallOption) (*SkipTaskOccurrenceResponse, error)
+	// Stop a task from repeating; the current occurrence is kept as a one-off task
+	StopTaskRecurrence(ctx context.Context, in *StopTaskRecurrenceRequest, opts ...grpc.CallOption) (*StopTaskRecurrenceResponse, error)
+	// Make a task depend on another, in any project; dependencies may not form a cycle
+	AddTaskDependency(ctx context.Context, in *AddTaskDependencyRequest, opts ...grpc.CallOption) (*AddTaskDependencyResponse, error)
+	// Remove a dependency between two tasks
+	RemoveTaskDependency(ctx context.Context, in *RemoveTaskDependencyRequest, opts ...grpc.CallOption) (*RemoveTaskDependencyResponse, error)
+	// Move a task to a new position among its project's tasks, or the Inbox's
+	ReorderTask(ctx context.Context, in *ReorderTaskRequest, opts ...grpc.CallOption) (*ReorderTaskResponse, error)
+	// Create several tasks in one transaction: either every request succeeds or
+	// none is applied. Every request is attempted; the error names the first
+	// request that failed and its BadRequest detail lists all of them
+	BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+	// Update several tasks in one transaction, all or nothing like BatchCreateTasks
+	BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error)
+	// Delete several tasks in one transaction, all or nothing like BatchCreateTasks
+	BatchDeleteTasks(ctx context.Context, in *BatchDeleteTasksRequest, opts ...grpc.CallOption) (*BatchDeleteTasksResponse, error)
 }
 
 type taskServiceClient struct {
@@ -102,6 +157,176 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReques
 	return out, nil
 }
 
+func (c *taskServiceClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*CompleteTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_CompleteTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReopenTask(ctx context.Context, in *ReopenTaskRequest, opts ...grpc.CallOption) (*ReopenTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReopenTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) FileTask(ctx context.Context, in *FileTaskRequest, opts ...grpc.CallOption) (*FileTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_FileTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) MoveTasks(ctx context.Context, in *MoveTasksRequest, opts ...grpc.CallOption) (*MoveTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_MoveTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CreateChecklistItem(ctx context.Context, in *CreateChecklistItemRequest, opts ...grpc.CallOption) (*CreateChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChecklistItemResponse)
+	err := c.cc.Invoke(ctx, TaskService_CreateChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*ToggleChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ToggleChecklistItemResponse)
+	err := c.cc.Invoke(ctx, TaskService_ToggleChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*ReorderChecklistItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderChecklistItemsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReorderChecklistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*DeleteChecklistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChecklistItemResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SetTaskRecurrence(ctx context.Context, in *SetTaskRecurrenceRequest, opts ...grpc.CallOption) (*SetTaskRecurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTaskRecurrenceResponse)
+	err := c.cc.Invoke(ctx, TaskService_SetTaskRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) SkipTaskOccurrence(ctx context.Context, in *SkipTaskOccurrenceRequest, opts ...grpc.CallOption) (*SkipTaskOccurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkipTaskOccurrenceResponse)
+	err := c.cc.Invoke(ctx, TaskService_SkipTaskOccurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) StopTaskRecurrence(ctx context.Context, in *StopTaskRecurrenceRequest, opts ...grpc.CallOption) (*StopTaskRecurrenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StopTaskRecurrenceResponse)
+	err := c.cc.Invoke(ctx, TaskService_StopTaskRecurrence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddTaskDependency(ctx context.Context, in *AddTaskDependencyRequest, opts ...grpc.CallOption) (*AddTaskDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTaskDependencyResponse)
+	err := c.cc.Invoke(ctx, TaskService_AddTaskDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RemoveTaskDependency(ctx context.Context, in *RemoveTaskDependencyRequest, opts ...grpc.CallOption) (*RemoveTaskDependencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTaskDependencyResponse)
+	err := c.cc.Invoke(ctx, TaskService_RemoveTaskDependency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderTask(ctx context.Context, in *ReorderTaskRequest, opts ...grpc.CallOption) (*ReorderTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReorderTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchCreateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchUpdateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchDeleteTasks(ctx context.Context, in *BatchDeleteTasksRequest, opts ...grpc.CallOption) (*BatchDeleteTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BatchDeleteTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -112,12 +337,50 @@ type TaskServiceServer interface {
 	CreateTask(context.Context, *CreateTaskRequest) (*CreateTaskResponse, error)
 	// Get a task by ID
 	GetTask(context.Context, *GetTaskRequest) (*GetTaskResponse, error)
-	// List tasks (optionally filtered by project)
+	// List tasks (optionally filtered by project or restricted to the Inbox)
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	// Update an existing task
 	UpdateTask(context.Context, *UpdateTaskRequest) (*UpdateTaskResponse, error)
-	// Delete a task
+	// Move a task to the trash
 	DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error)
+	// Mark a task as completed or cancelled; completing a recurring task creates its next occurrence.
+	// A task already in the requested state is returned unchanged; one in the
+	// other finished state fails with FAILED_PRECONDITION until it is reopened.
+	CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error)
+	// Reopen a completed or cancelled task
+	ReopenTask(context.Context, *ReopenTaskRequest) (*ReopenTaskResponse, error)
+	// File a task (typically from the Inbox) into a project
+	FileTask(context.Context, *FileTaskRequest) (*FileTaskResponse, error)
+	// Move several tasks to a project (or the Inbox) in a single transaction
+	MoveTasks(context.Context, *MoveTasksRequest) (*MoveTasksResponse, error)
+	// Add an item to the end of a task's checklist
+	CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CreateChecklistItemResponse, error)
+	// Tick off or untick a checklist item
+	ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*ToggleChecklistItemResponse, error)
+	// Reorder all items of a task's checklist
+	ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ReorderChecklistItemsResponse, error)
+	// Delete a checklist item
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error)
+	// Make a task repeat, or change the rule for all future occurrences of its series
+	SetTaskRecurrence(context.Context, *SetTaskRecurrenceRequest) (*SetTaskRecurrenceResponse, error)
+	// Skip the current occurrence of a recurring task, moving it to the next date
+	SkipTaskOccurrence(context.Context, *SkipTaskOccurrenceRequest) (*SkipTaskOccurrenceResponse, error)
+	// Stop a task from repeating; the current occurrence is kept as a one-off task
+	StopTaskRecurrence(context.Context, *StopTaskRecurrenceRequest) (*StopTaskRecurrenceResponse, error)
+	// Make a task depend on another, in any project; dependencies may not form a cycle
+	AddTaskDependency(context.Context, *AddTaskDependencyRequest) (*AddTaskDependencyResponse, error)
+	// Remove a dependency between two tasks
+	RemoveTaskDependency(context.Context, *RemoveTaskDependencyRequest) (*RemoveTaskDependencyResponse, error)
+	// Move a task to a new position among its project's tasks, or the Inbox's
+	ReorderTask(context.Context, *ReorderTaskRequest) (*ReorderTaskResponse, error)
+	// Create several tasks in one transaction: either every request succeeds or
+	// none is applied. Every request is attempted; the error names the first
+	// request that failed and its BadRequest detail lists all of them
+	BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	// Update several tasks in one transaction, all or nothing like BatchCreateTasks
+	BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error)
+	// Delete several tasks in one transaction, all or nothing like BatchCreateTasks
+	BatchDeleteTasks(context.Context, *BatchDeleteTasksRequest) (*BatchDeleteTasksResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -143,6 +406,57 @@ func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskReq
 func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*DeleteTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTaskServiceServer) CompleteTask(context.Context, *CompleteTaskRequest) (*CompleteTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ReopenTask(context.Context, *ReopenTaskRequest) (*ReopenTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReopenTask not implemented")
+}
+func (UnimplementedTaskServiceServer) FileTask(context.Context, *FileTaskRequest) (*FileTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FileTask not implemented")
+}
+func (UnimplementedTaskServiceServer) MoveTasks(context.Context, *MoveTasksRequest) (*MoveTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateChecklistItem(context.Context, *CreateChecklistItemRequest) (*CreateChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*ToggleChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ToggleChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ReorderChecklistItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderChecklistItems not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*DeleteChecklistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) SetTaskRecurrence(context.Context, *SetTaskRecurrenceRequest) (*SetTaskRecurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTaskRecurrence not implemented")
+}
+func (UnimplementedTaskServiceServer) SkipTaskOccurrence(context.Context, *SkipTaskOccurrenceRequest) (*SkipTaskOccurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SkipTaskOccurrence not implemented")
+}
+func (UnimplementedTaskServiceServer) StopTaskRecurrence(context.Context, *StopTaskRecurrenceRequest) (*StopTaskRecurrenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StopTaskRecurrence not implemented")
+}
+func (UnimplementedTaskServiceServer) AddTaskDependency(context.Context, *AddTaskDependencyRequest) (*AddTaskDependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTaskDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) RemoveTaskDependency(context.Context, *RemoveTaskDependencyRequest) (*RemoveTaskDependencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveTaskDependency not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderTask(context.Context, *ReorderTaskRequest) (*ReorderTaskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderTask not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchCreateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchUpdateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchDeleteTasks(context.Context, *BatchDeleteTasksRequest) (*BatchDeleteTasksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDeleteTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -254,6 +568,312 @@ func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CompleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CompleteTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReopenTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReopenTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReopenTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReopenTask(ctx, req.(*ReopenTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_FileTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).FileTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_FileTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).FileTask(ctx, req.(*FileTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_MoveTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).MoveTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_MoveTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).MoveTasks(ctx, req.(*MoveTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CreateChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateChecklistItem(ctx, req.(*CreateChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ToggleChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ToggleChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, req.(*ToggleChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReorderChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderChecklistItems(ctx, req.(*ReorderChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SetTaskRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SetTaskRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SetTaskRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SetTaskRecurrence(ctx, req.(*SetTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SkipTaskOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkipTaskOccurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SkipTaskOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SkipTaskOccurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SkipTaskOccurrence(ctx, req.(*SkipTaskOccurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_StopTaskRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTaskRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).StopTaskRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_StopTaskRecurrence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).StopTaskRecurrence(ctx, req.(*StopTaskRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddTaskDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddTaskDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddTaskDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddTaskDependency(ctx, req.(*AddTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RemoveTaskDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTaskDependencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RemoveTaskDependency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RemoveTaskDependency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RemoveTaskDependency(ctx, req.(*RemoveTaskDependencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReorderTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderTask(ctx, req.(*ReorderTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchCreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchCreateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchCreateTasks(ctx, req.(*BatchCreateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchUpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchUpdateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchUpdateTasks(ctx, req.(*BatchUpdateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchDeleteTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchDeleteTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchDeleteTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchDeleteTasks(ctx, req.(*BatchDeleteTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +901,74 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TaskService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _TaskService_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ReopenTask",
+			Handler:    _TaskService_ReopenTask_Handler,
+		},
+		{
+			MethodName: "FileTask",
+			Handler:    _TaskService_FileTask_Handler,
+		},
+		{
+			MethodName: "MoveTasks",
+			Handler:    _TaskService_MoveTasks_Handler,
+		},
+		{
+			MethodName: "CreateChecklistItem",
+			Handler:    _TaskService_CreateChecklistItem_Handler,
+		},
+		{
+			MethodName: "ToggleChecklistItem",
+			Handler:    _TaskService_ToggleChecklistItem_Handler,
+		},
+		{
+			MethodName: "ReorderChecklistItems",
+			Handler:    _TaskService_ReorderChecklistItems_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _TaskService_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "SetTaskRecurrence",
+			Handler:    _TaskService_SetTaskRecurrence_Handler,
+		},
+		{
+			MethodName: "SkipTaskOccurrence",
+			Handler:    _TaskService_SkipTaskOccurrence_Handler,
+		},
+		{
+			MethodName: "StopTaskRecurrence",
+			Handler:    _TaskService_StopTaskRecurrence_Handler,
+		},
+		{
+			MethodName: "AddTaskDependency",
+			Handler:    _TaskService_AddTaskDependency_Handler,
+		},
+		{
+			MethodName: "RemoveTaskDependency",
+			Handler:    _TaskService_RemoveTaskDependency_Handler,
+		},
+		{
+			MethodName: "ReorderTask",
+			Handler:    _TaskService_ReorderTask_Handler,
+		},
+		{
+			MethodName: "BatchCreateTasks",
+			Handler:    _TaskService_BatchCreateTasks_Handler,
+		},
+		{
+			MethodName: "BatchUpdateTasks",
+			Handler:    _TaskService_BatchUpdateTasks_Handler,
+		},
+		{
+			MethodName: "BatchDeleteTasks",
+			Handler:    _TaskService_BatchDeleteTasks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "planner/v1/task.proto",
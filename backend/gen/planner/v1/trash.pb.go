@@ -0,0 +1,529 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: planner/v1/trash.proto
+
+package plannerv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TrashItem is a deleted area, project or task that can still be restored
+type TrashItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the deleted item
+	Type EntityType `protobuf:"varint,1,opt,name=type,proto3,enum=planner.v1.EntityType" json:"type,omitempty"`
+	// ID of the deleted item
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the deleted item
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Timestamp when the item was moved to the trash
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	mi := &file_planner_v1_trash_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrashItem) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *TrashItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// Request to list the items in the trash
+type ListTrashRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of items to return (defaults to 50, capped at 500)
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Token from a previous response's next_page_token to continue listing; the
+	// request's other fields, apart from page_size, must be the same as before
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only list items of this type (if provided)
+	Type          EntityType `protobuf:"varint,3,opt,name=type,proto3,enum=planner.v1.EntityType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashRequest) Reset() {
+	*x = ListTrashRequest{}
+	mi := &file_planner_v1_trash_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashRequest) ProtoMessage() {}
+
+func (x *ListTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTrashRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTrashRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTrashRequest) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+// Response containing the items in the trash, most recently deleted first.
+// Items deleted along with their parent are not listed separately.
+type ListTrashResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of trashed items
+	Items []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Token for the next page, empty when there are no more results
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashResponse) Reset() {
+	*x = ListTrashResponse{}
+	mi := &file_planner_v1_trash_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashResponse) ProtoMessage() {}
+
+func (x *ListTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTrashResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request to restore an item from the trash
+type RestoreItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the item to restore
+	Type EntityType `protobuf:"varint,1,opt,name=type,proto3,enum=planner.v1.EntityType" json:"type,omitempty"`
+	// ID of the item to restore
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreItemRequest) Reset() {
+	*x = RestoreItemRequest{}
+	mi := &file_planner_v1_trash_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreItemRequest) ProtoMessage() {}
+
+func (x *RestoreItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreItemRequest.ProtoReflect.Descriptor instead.
+func (*RestoreItemRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RestoreItemRequest) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *RestoreItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response confirming the restore
+type RestoreItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Success status
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreItemResponse) Reset() {
+	*x = RestoreItemResponse{}
+	mi := &file_planner_v1_trash_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreItemResponse) ProtoMessage() {}
+
+func (x *RestoreItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreItemResponse.ProtoReflect.Descriptor instead.
+func (*RestoreItemResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestoreItemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Request to permanently delete everything in the trash
+type EmptyTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyTrashRequest) Reset() {
+	*x = EmptyTrashRequest{}
+	mi := &file_planner_v1_trash_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashRequest) ProtoMessage() {}
+
+func (x *EmptyTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashRequest.ProtoReflect.Descriptor instead.
+func (*EmptyTrashRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{5}
+}
+
+// Response reporting what was permanently deleted
+type EmptyTrashResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of areas deleted
+	AreasDeleted int64 `protobuf:"varint,1,opt,name=areas_deleted,json=areasDeleted,proto3" json:"areas_deleted,omitempty"`
+	// Number of projects deleted
+	ProjectsDeleted int64 `protobuf:"varint,2,opt,name=projects_deleted,json=projectsDeleted,proto3" json:"projects_deleted,omitempty"`
+	// Number of tasks deleted
+	TasksDeleted  int64 `protobuf:"varint,3,opt,name=tasks_deleted,json=tasksDeleted,proto3" json:"tasks_deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyTrashResponse) Reset() {
+	*x = EmptyTrashResponse{}
+	mi := &file_planner_v1_trash_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyTrashResponse) ProtoMessage() {}
+
+func (x *EmptyTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1_trash_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyTrashResponse.ProtoReflect.Descriptor instead.
+func (*EmptyTrashResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1_trash_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmptyTrashResponse) GetAreasDeleted() int64 {
+	if x != nil {
+		return x.AreasDeleted
+	}
+	return 0
+}
+
+func (x *EmptyTrashResponse) GetProjectsDeleted() int64 {
+	if x != nil {
+		return x.ProjectsDeleted
+	}
+	return 0
+}
+
+func (x *EmptyTrashResponse) GetTasksDeleted() int64 {
+	if x != nil {
+		return x.TasksDeleted
+	}
+	return 0
+}
+
+var File_planner_v1_trash_proto protoreflect.FileDescriptor
+
+const file_planner_v1_trash_proto_rawDesc = "" +
+	"\n" +
+	"\x16planner/v1/trash.proto\x12\n" +
+	"planner.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bbuf/validate/validate.proto\x1a\x17planner/v1/common.proto\"\x96\x01\n" +
+	"\tTrashItem\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.planner.v1.EntityTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"deleted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x8d\x01\n" +
+	"\x10ListTrashRequest\x12$\n" +
+	"\tpage_size\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x16.planner.v1.EntityTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04type\"h\n" +
+	"\x11ListTrashResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.planner.v1.TrashItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"f\n" +
+	"\x12RestoreItemRequest\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.planner.v1.EntityTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04type\x12\x18\n" +
+	"\x02id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"/\n" +
+	"\x13RestoreItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x13\n" +
+	"\x11EmptyTrashRequest\"\x89\x01\n" +
+	"\x12EmptyTrashResponse\x12#\n" +
+	"\rareas_deleted\x18\x01 \x01(\x03R\fareasDeleted\x12)\n" +
+	"\x10projects_deleted\x18\x02 \x01(\x03R\x0fprojectsDeleted\x12#\n" +
+	"\rtasks_deleted\x18\x03 \x01(\x03R\ftasksDeleted2\xf5\x01\n" +
+	"\fTrashService\x12H\n" +
+	"\tListTrash\x12\x1c.planner.v1.ListTrashRequest\x1a\x1d.planner.v1.ListTrashResponse\x12N\n" +
+	"\vRestoreItem\x12\x1e.planner.v1.RestoreItemRequest\x1a\x1f.planner.v1.RestoreItemResponse\x12K\n" +
+	"\n" +
+	"EmptyTrash\x12\x1d.planner.v1.EmptyTrashRequest\x1a\x1e.planner.v1.EmptyTrashResponseB\xa5\x01\n" +
+	"\x0ecom.planner.v1B\n" +
+	"TrashProtoP\x01Z>github.com/liamawhite/planner/backend/gen/planner/v1;plannerv1\xa2\x02\x03PXX\xaa\x02\n" +
+	"Planner.V1\xca\x02\n" +
+	"Planner\\V1\xe2\x02\x16Planner\\V1\\GPBMetadata\xea\x02\vPlanner::V1b\x06proto3"
+
+var (
+	file_planner_v1_trash_proto_rawDescOnce sync.Once
+	file_planner_v1_trash_proto_rawDescData []byte
+)
+
+func file_planner_v1_trash_proto_rawDescGZIP() []byte {
+	file_planner_v1_trash_proto_rawDescOnce.Do(func() {
+		file_planner_v1_trash_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_planner_v1_trash_proto_rawDesc), len(file_planner_v1_trash_proto_rawDesc)))
+	})
+	return file_planner_v1_trash_proto_rawDescData
+}
+
+var file_planner_v1_trash_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_planner_v1_trash_proto_goTypes = []any{
+	(*TrashItem)(nil),             // 0: planner.v1.TrashItem
+	(*ListTrashRequest)(nil),      // 1: planner.v1.ListTrashRequest
+	(*ListTrashResponse)(nil),     // 2: planner.v1.ListTrashResponse
+	(*RestoreItemRequest)(nil),    // 3: planner.v1.RestoreItemRequest
+	(*RestoreItemResponse)(nil),   // 4: planner.v1.RestoreItemResponse
+	(*EmptyTrashRequest)(nil),     // 5: planner.v1.EmptyTrashRequest
+	(*EmptyTrashResponse)(nil),    // 6: planner.v1.EmptyTrashResponse
+	(EntityType)(0),               // 7: planner.v1.EntityType
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+}
+var file_planner_v1_trash_proto_depIdxs = []int32{
+	7, // 0: planner.v1.TrashItem.type:type_name -> planner.v1.EntityType
+	8, // 1: planner.v1.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	7, // 2: planner.v1.ListTrashRequest.type:type_name -> planner.v1.EntityType
+	0, // 3: planner.v1.ListTrashResponse.items:type_name -> planner.v1.TrashItem
+	7, // 4: planner.v1.RestoreItemRequest.type:type_name -> planner.v1.EntityType
+	1, // 5: planner.v1.TrashService.ListTrash:input_type -> planner.v1.ListTrashRequest
+	3, // 6: planner.v1.TrashService.RestoreItem:input_type -> planner.v1.RestoreItemRequest
+	5, // 7: planner.v1.TrashService.EmptyTrash:input_type -> planner.v1.EmptyTrashRequest
+	2, // 8: planner.v1.TrashService.ListTrash:output_type -> planner.v1.ListTrashResponse
+	4, // 9: planner.v1.TrashService.RestoreItem:output_type -> planner.v1.RestoreItemResponse
+	6, // 10: planner.v1.TrashService.EmptyTrash:output_type -> planner.v1.EmptyTrashResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_planner_v1_trash_proto_init() }
+func file_planner_v1_trash_proto_init() {
+	if File_planner_v1_trash_proto != nil {
+		return
+	}
+	file_planner_v1_common_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1_trash_proto_rawDesc), len(file_planner_v1_trash_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_planner_v1_trash_proto_goTypes,
+		DependencyIndexes: file_planner_v1_trash_proto_depIdxs,
+		MessageInfos:      file_planner_v1_trash_proto_msgTypes,
+	}.Build()
+	File_planner_v1_trash_proto = out.File
+	file_planner_v1_trash_proto_goTypes = nil
+	file_planner_v1_trash_proto_depIdxs = nil
+}
@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: planner/v1/trash.proto
+
+package plannerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TrashService_ListTrash_FullMethodName   = "/planner.v1.TrashService/ListTrash"
+	TrashService_RestoreItem_FullMethodName = "/planner.v1.TrashService/RestoreItem"
+	TrashService_EmptyTrash_FullMethodName  = "/planner.v1.TrashService/EmptyTrash"
+)
+
+// TrashServiceClient is the client API for TrashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TrashService manages deleted items. Deleting an area, project or task moves
+// it to the trash, where it stays until restored, emptied or purged after the
+// server's retention period.
+type TrashServiceClient interface {
+	// List the items in the trash
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// Restore an item, and the children deleted along with it, from the trash
+	RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error)
+	// Permanently delete everything in the trash
+	EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error)
+}
+
+type trashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTrashServiceClient(cc grpc.ClientConnInterface) TrashServiceClient {
+	return &trashServiceClient{cc}
+}
+
+func (c *trashServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, TrashService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashServiceClient) RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreItemResponse)
+	err := c.cc.Invoke(ctx, TrashService_RestoreItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trashServiceClient) EmptyTrash(ctx context.Context, in *EmptyTrashRequest, opts ...grpc.CallOption) (*EmptyTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyTrashResponse)
+	err := c.cc.Invoke(ctx, TrashService_EmptyTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrashServiceServer is the server API for TrashService service.
+// All implementations must embed UnimplementedTrashServiceServer
+// for forward compatibility.
+//
+// TrashService manages deleted items. Deleting an area, project or task moves
+// it to the trash, where it stays until restored, emptied or purged after the
+// server's retention period.
+type TrashServiceServer interface {
+	// List the items in the trash
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// Restore an item, and the children deleted along with it, from the trash
+	RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error)
+	// Permanently delete everything in the trash
+	EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error)
+	mustEmbedUnimplementedTrashServiceServer()
+}
+
+// UnimplementedTrashServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTrashServiceServer struct{}
+
+func (UnimplementedTrashServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedTrashServiceServer) RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreItem not implemented")
+}
+func (UnimplementedTrashServiceServer) EmptyTrash(context.Context, *EmptyTrashRequest) (*EmptyTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method EmptyTrash not implemented")
+}
+func (UnimplementedTrashServiceServer) mustEmbedUnimplementedTrashServiceServer() {}
+func (UnimplementedTrashServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeTrashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TrashServiceServer will
+// result in compilation errors.
+type UnsafeTrashServiceServer interface {
+	mustEmbedUnimplementedTrashServiceServer()
+}
+
+func RegisterTrashServiceServer(s grpc.ServiceRegistrar, srv TrashServiceServer) {
+	// If the following call panics, it indicates UnimplementedTrashServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TrashService_ServiceDesc, srv)
+}
+
+func _TrashService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashService_RestoreItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).RestoreItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_RestoreItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).RestoreItem(ctx, req.(*RestoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrashService_EmptyTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrashServiceServer).EmptyTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrashService_EmptyTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrashServiceServer).EmptyTrash(ctx, req.(*EmptyTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TrashService_ServiceDesc is the grpc.ServiceDesc for TrashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TrashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "planner.v1.TrashService",
+	HandlerType: (*TrashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTrash",
+			Handler:    _TrashService_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreItem",
+			Handler:    _TrashService_RestoreItem_Handler,
+		},
+		{
+			MethodName: "EmptyTrash",
+			Handler:    _TrashService_EmptyTrash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "planner/v1/trash.proto",
+}
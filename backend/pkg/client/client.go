@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
+	"iter"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
 )
@@ -16,6 +19,11 @@ type Client struct {
 	areaService    pb.AreaServiceClient
 	projectService pb.ProjectServiceClient
 	taskService    pb.TaskServiceClient
+	tagService     pb.TagServiceClient
+	trashService   pb.TrashServiceClient
+	searchService  pb.SearchServiceClient
+	changeService  pb.ChangeServiceClient
+	backupService  pb.BackupServiceClient
 }
 
 // New creates a new client connected to the specified address
@@ -33,6 +41,11 @@ func New(address string) (*Client, error) {
 		areaService:    pb.NewAreaServiceClient(conn),
 		projectService: pb.NewProjectServiceClient(conn),
 		taskService:    pb.NewTaskServiceClient(conn),
+		tagService:     pb.NewTagServiceClient(conn),
+		trashService:   pb.NewTrashServiceClient(conn),
+		searchService:  pb.NewSearchServiceClient(conn),
+		changeService:  pb.NewChangeServiceClient(conn),
+		backupService:  pb.NewBackupServiceClient(conn),
 	}, nil
 }
 
@@ -67,21 +80,41 @@ func (c *Client) GetArea(ctx context.Context, id string) (*pb.Area, error) {
 	return resp.Area, nil
 }
 
-// ListAreas lists all areas
-func (c *Client) ListAreas(ctx context.Context) ([]*pb.Area, error) {
-	resp, err := c.areaService.ListAreas(ctx, &pb.ListAreasRequest{})
+// ListAreas lists the areas matching the filters in req, in the order it asks for.
+// The page_token of req is ignored.
+func (c *Client) ListAreas(ctx context.Context, req *pb.ListAreasRequest) ([]*pb.Area, error) {
+	return collect(c.AllAreas(ctx, req))
+}
+
+// AllAreas iterates over the areas matching the filters in req, fetching further pages as needed.
+// The page_token of req is ignored.
+func (c *Client) AllAreas(ctx context.Context, req *pb.ListAreasRequest) iter.Seq2[*pb.Area, error] {
+	return pages(func(pageToken string) ([]*pb.Area, string, error) {
+		pageReq := proto.CloneOf(req)
+		pageReq.PageToken = pageToken
+		resp, err := c.areaService.ListAreas(ctx, pageReq)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Areas, resp.NextPageToken, nil
+	})
+}
+
+// ArchiveArea archives an area, hiding it and its projects and tasks from lists
+func (c *Client) ArchiveArea(ctx context.Context, id string) (*pb.Area, error) {
+	resp, err := c.areaService.ArchiveArea(ctx, &pb.ArchiveAreaRequest{
+		Id: id,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return resp.Areas, nil
+	return resp.Area, nil
 }
 
-// UpdateArea updates an existing area
-func (c *Client) UpdateArea(ctx context.Context, id string, name, description *string) (*pb.Area, error) {
-	resp, err := c.areaService.UpdateArea(ctx, &pb.UpdateAreaRequest{
-		Id:          id,
-		Name:        name,
-		Description: description,
+// UnarchiveArea unarchives an area
+func (c *Client) UnarchiveArea(ctx context.Context, id string) (*pb.Area, error) {
+	resp, err := c.areaService.UnarchiveArea(ctx, &pb.UnarchiveAreaRequest{
+		Id: id,
 	})
 	if err != nil {
 		return nil, err
@@ -89,11 +122,34 @@ func (c *Client) UpdateArea(ctx context.Context, id string, name, description *s
 	return resp.Area, nil
 }
 
-// DeleteArea deletes an area
-func (c *Client) DeleteArea(ctx context.Context, id string) error {
-	_, err := c.areaService.DeleteArea(ctx, &pb.DeleteAreaRequest{
-		Id: id,
+// UpdateArea updates the fields of an area set in req. With expected_version set
+// it fails with codes.Aborted if the area has been changed since.
+func (c *Client) UpdateArea(ctx context.Context, req *pb.UpdateAreaRequest) (*pb.Area, error) {
+	resp, err := c.areaService.UpdateArea(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Area, nil
+}
+
+// ReorderArea moves an area directly after afterID and/or before beforeID in the manual order
+func (c *Client) ReorderArea(ctx context.Context, id, afterID, beforeID string) (*pb.Area, error) {
+	resp, err := c.areaService.ReorderArea(ctx, &pb.ReorderAreaRequest{
+		Id:       id,
+		AfterId:  afterID,
+		BeforeId: beforeID,
 	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Area, nil
+}
+
+// DeleteArea deletes an area, handling its projects according to the mode of req.
+// With expected_version set it fails with codes.Aborted if the area has been
+// changed since.
+func (c *Client) DeleteArea(ctx context.Context, req *pb.DeleteAreaRequest) error {
+	_, err := c.areaService.DeleteArea(ctx, req)
 	return err
 }
 
@@ -121,25 +177,77 @@ func (c *Client) GetProject(ctx context.Context, id string) (*pb.Project, error)
 	return resp.Project, nil
 }
 
-// ListProjects lists projects, optionally filtered by area
-func (c *Client) ListProjects(ctx context.Context, areaID *string) ([]*pb.Project, error) {
-	req := &pb.ListProjectsRequest{}
-	if areaID != nil {
-		req.AreaId = areaID
+// ListProjects lists the projects matching the filters in req, in the order it asks for.
+// The page_token of req is ignored.
+func (c *Client) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) ([]*pb.Project, error) {
+	return collect(c.AllProjects(ctx, req))
+}
+
+// AllProjects iterates over the projects matching the filters in req, fetching further pages
+// as needed. The page_token of req is ignored.
+func (c *Client) AllProjects(ctx context.Context, req *pb.ListProjectsRequest) iter.Seq2[*pb.Project, error] {
+	return pages(func(pageToken string) ([]*pb.Project, string, error) {
+		pageReq := proto.CloneOf(req)
+		pageReq.PageToken = pageToken
+		resp, err := c.projectService.ListProjects(ctx, pageReq)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Projects, resp.NextPageToken, nil
+	})
+}
+
+// UpdateProject updates the fields of a project set in req, including its area,
+// deadline and tags. With expected_version set it fails with codes.Aborted if
+// the project has been changed since.
+func (c *Client) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.Project, error) {
+	resp, err := c.projectService.UpdateProject(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Project, nil
+}
+
+// ReorderProject moves a project directly after afterID and/or before beforeID in the manual order
+func (c *Client) ReorderProject(ctx context.Context, id, afterID, beforeID string) (*pb.Project, error) {
+	resp, err := c.projectService.ReorderProject(ctx, &pb.ReorderProjectRequest{
+		Id:       id,
+		AfterId:  afterID,
+		BeforeId: beforeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Project, nil
+}
+
+// CompleteProject marks a project as completed, handling its open tasks according to openTasks
+func (c *Client) CompleteProject(ctx context.Context, id string, openTasks pb.OpenTaskAction) (*pb.Project, error) {
+	resp, err := c.projectService.CompleteProject(ctx, &pb.CompleteProjectRequest{
+		Id:        id,
+		OpenTasks: openTasks,
+	})
+	if err != nil {
+		return nil, err
 	}
-	resp, err := c.projectService.ListProjects(ctx, req)
+	return resp.Project, nil
+}
+
+// DeferProject parks an active project for some day
+func (c *Client) DeferProject(ctx context.Context, id string) (*pb.Project, error) {
+	resp, err := c.projectService.DeferProject(ctx, &pb.DeferProjectRequest{
+		Id: id,
+	})
 	if err != nil {
 		return nil, err
 	}
-	return resp.Projects, nil
+	return resp.Project, nil
 }
 
-// UpdateProject updates an existing project
-func (c *Client) UpdateProject(ctx context.Context, id string, name, notes *string) (*pb.Project, error) {
-	resp, err := c.projectService.UpdateProject(ctx, &pb.UpdateProjectRequest{
-		Id:    id,
-		Name:  name,
-		Notes: notes,
+// ArchiveProject puts a project away for reference
+func (c *Client) ArchiveProject(ctx context.Context, id string) (*pb.Project, error) {
+	resp, err := c.projectService.ArchiveProject(ctx, &pb.ArchiveProjectRequest{
+		Id: id,
 	})
 	if err != nil {
 		return nil, err
@@ -147,15 +255,125 @@ func (c *Client) UpdateProject(ctx context.Context, id string, name, notes *stri
 	return resp.Project, nil
 }
 
-// DeleteProject deletes a project
-func (c *Client) DeleteProject(ctx context.Context, id string) error {
-	_, err := c.projectService.DeleteProject(ctx, &pb.DeleteProjectRequest{
+// ActivateProject makes a someday, completed or archived project active again
+func (c *Client) ActivateProject(ctx context.Context, id string) (*pb.Project, error) {
+	resp, err := c.projectService.ActivateProject(ctx, &pb.ActivateProjectRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Project, nil
+}
+
+// DeleteProject deletes a project, handling its tasks according to the mode of req.
+// With expected_version set it fails with codes.Aborted if the project has been
+// changed since.
+func (c *Client) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) error {
+	_, err := c.projectService.DeleteProject(ctx, req)
+	return err
+}
+
+// BatchCreateProjects creates several projects in one transaction, returning them in
+// request order. If any request fails none of the projects are created.
+func (c *Client) BatchCreateProjects(ctx context.Context, reqs []*pb.CreateProjectRequest) ([]*pb.Project, error) {
+	resp, err := c.projectService.BatchCreateProjects(ctx, &pb.BatchCreateProjectsRequest{
+		Requests: reqs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Projects, nil
+}
+
+// BatchUpdateProjects updates several projects in one transaction, returning them in
+// request order. If any request fails none of the updates are applied.
+func (c *Client) BatchUpdateProjects(ctx context.Context, reqs []*pb.UpdateProjectRequest) ([]*pb.Project, error) {
+	resp, err := c.projectService.BatchUpdateProjects(ctx, &pb.BatchUpdateProjectsRequest{
+		Requests: reqs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Projects, nil
+}
+
+// BatchDeleteProjects moves several projects to the trash in one transaction. If any
+// request fails none of the projects are deleted.
+func (c *Client) BatchDeleteProjects(ctx context.Context, reqs []*pb.DeleteProjectRequest) error {
+	_, err := c.projectService.BatchDeleteProjects(ctx, &pb.BatchDeleteProjectsRequest{
+		Requests: reqs,
+	})
+	return err
+}
+
+// GetProjectOutline returns a project's tasks grouped under its headings;
+// completed and cancelled tasks are only included when includeFinished is set
+func (c *Client) GetProjectOutline(ctx context.Context, projectID string, includeFinished bool) (*pb.GetProjectOutlineResponse, error) {
+	return c.projectService.GetProjectOutline(ctx, &pb.GetProjectOutlineRequest{
+		ProjectId:       projectID,
+		IncludeFinished: includeFinished,
+	})
+}
+
+// CreateHeading adds a heading to the end of a project
+func (c *Client) CreateHeading(ctx context.Context, projectID, name string) (*pb.Heading, error) {
+	resp, err := c.projectService.CreateHeading(ctx, &pb.CreateHeadingRequest{
+		ProjectId: projectID,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Heading, nil
+}
+
+// RenameHeading renames a heading
+func (c *Client) RenameHeading(ctx context.Context, id, name string) (*pb.Heading, error) {
+	resp, err := c.projectService.RenameHeading(ctx, &pb.RenameHeadingRequest{
+		Id:   id,
+		Name: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Heading, nil
+}
+
+// ReorderHeading moves a heading directly after afterID and/or before beforeID within its project
+func (c *Client) ReorderHeading(ctx context.Context, id, afterID, beforeID string) (*pb.Heading, error) {
+	resp, err := c.projectService.ReorderHeading(ctx, &pb.ReorderHeadingRequest{
+		Id:       id,
+		AfterId:  afterID,
+		BeforeId: beforeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Heading, nil
+}
+
+// MoveHeading moves a heading and all of its tasks to another project
+func (c *Client) MoveHeading(ctx context.Context, id, projectID string) (*pb.Heading, error) {
+	resp, err := c.projectService.MoveHeading(ctx, &pb.MoveHeadingRequest{
+		Id:        id,
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Heading, nil
+}
+
+// DeleteHeading deletes a heading, leaving its tasks in the project without a heading
+func (c *Client) DeleteHeading(ctx context.Context, id string) error {
+	_, err := c.projectService.DeleteHeading(ctx, &pb.DeleteHeadingRequest{
 		Id: id,
 	})
 	return err
 }
 
-// CreateTask creates a new task
+// CreateTask creates a new task; an empty projectID captures it in the Inbox
 func (c *Client) CreateTask(ctx context.Context, name, notes, projectID string) (*pb.Task, error) {
 	resp, err := c.taskService.CreateTask(ctx, &pb.CreateTaskRequest{
 		Name:      name,
@@ -179,25 +397,217 @@ func (c *Client) GetTask(ctx context.Context, id string) (*pb.Task, error) {
 	return resp.Task, nil
 }
 
-// ListTasks lists tasks, optionally filtered by project
-func (c *Client) ListTasks(ctx context.Context, projectID *string) ([]*pb.Task, error) {
-	req := &pb.ListTasksRequest{}
-	if projectID != nil {
-		req.ProjectId = projectID
+// ListTasks lists the tasks matching the filters in req, in the order it asks for.
+// The page_token of req is ignored.
+func (c *Client) ListTasks(ctx context.Context, req *pb.ListTasksRequest) ([]*pb.Task, error) {
+	return collect(c.AllTasks(ctx, req))
+}
+
+// AllTasks iterates over all tasks matching the filters in req, fetching further pages as needed.
+// The page_token of req is ignored.
+func (c *Client) AllTasks(ctx context.Context, req *pb.ListTasksRequest) iter.Seq2[*pb.Task, error] {
+	return pages(func(pageToken string) ([]*pb.Task, string, error) {
+		pageReq := proto.CloneOf(req)
+		pageReq.PageToken = pageToken
+		resp, err := c.taskService.ListTasks(ctx, pageReq)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Tasks, resp.NextPageToken, nil
+	})
+}
+
+// UpdateTask updates the fields of a task set in req, including its project,
+// heading, dates and tags. With expected_version set it fails with codes.Aborted
+// if the task has been changed since.
+func (c *Client) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.Task, error) {
+	resp, err := c.taskService.UpdateTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// ReorderTask moves a task directly after afterID and/or before beforeID in the manual order
+func (c *Client) ReorderTask(ctx context.Context, id, afterID, beforeID string) (*pb.Task, error) {
+	resp, err := c.taskService.ReorderTask(ctx, &pb.ReorderTaskRequest{
+		Id:       id,
+		AfterId:  afterID,
+		BeforeId: beforeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// DeleteTask moves a task to the trash. With expected_version set it fails with
+// codes.Aborted if the task has been changed since.
+func (c *Client) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) error {
+	_, err := c.taskService.DeleteTask(ctx, req)
+	return err
+}
+
+// BatchCreateTasks creates several tasks in one transaction, returning them in
+// request order. If any request fails none of the tasks are created.
+func (c *Client) BatchCreateTasks(ctx context.Context, reqs []*pb.CreateTaskRequest) ([]*pb.Task, error) {
+	resp, err := c.taskService.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{
+		Requests: reqs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tasks, nil
+}
+
+// BatchUpdateTasks updates several tasks in one transaction, returning them in
+// request order. If any request fails none of the updates are applied.
+func (c *Client) BatchUpdateTasks(ctx context.Context, reqs []*pb.UpdateTaskRequest) ([]*pb.Task, error) {
+	resp, err := c.taskService.BatchUpdateTasks(ctx, &pb.BatchUpdateTasksRequest{
+		Requests: reqs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tasks, nil
+}
+
+// BatchDeleteTasks moves several tasks to the trash in one transaction. If any
+// request fails none of the tasks are deleted.
+func (c *Client) BatchDeleteTasks(ctx context.Context, reqs []*pb.DeleteTaskRequest) error {
+	_, err := c.taskService.BatchDeleteTasks(ctx, &pb.BatchDeleteTasksRequest{
+		Requests: reqs,
+	})
+	return err
+}
+
+// CompleteTask marks a task as completed, or as cancelled if req asks to cancel it
+func (c *Client) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.Task, error) {
+	resp, err := c.taskService.CompleteTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// ReopenTask marks a completed or cancelled task as open again
+func (c *Client) ReopenTask(ctx context.Context, id string) (*pb.Task, error) {
+	resp, err := c.taskService.ReopenTask(ctx, &pb.ReopenTaskRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// FileTask moves a task (typically from the Inbox) into a project
+func (c *Client) FileTask(ctx context.Context, id, projectID string) (*pb.Task, error) {
+	resp, err := c.taskService.FileTask(ctx, &pb.FileTaskRequest{
+		Id:        id,
+		ProjectId: projectID,
+	})
+	if err != nil {
+		return nil, err
 	}
-	resp, err := c.taskService.ListTasks(ctx, req)
+	return resp.Task, nil
+}
+
+// MoveTasks moves several tasks to a project in one transaction; an empty projectID moves them to the Inbox
+func (c *Client) MoveTasks(ctx context.Context, ids []string, projectID string) ([]*pb.Task, error) {
+	resp, err := c.taskService.MoveTasks(ctx, &pb.MoveTasksRequest{
+		TaskIds:   ids,
+		ProjectId: projectID,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return resp.Tasks, nil
 }
 
-// UpdateTask updates an existing task
-func (c *Client) UpdateTask(ctx context.Context, id string, name, notes *string) (*pb.Task, error) {
-	resp, err := c.taskService.UpdateTask(ctx, &pb.UpdateTaskRequest{
-		Id:    id,
-		Name:  name,
-		Notes: notes,
+// CreateChecklistItem adds an item to the end of a task's checklist
+func (c *Client) CreateChecklistItem(ctx context.Context, taskID, name string) (*pb.ChecklistItem, error) {
+	resp, err := c.taskService.CreateChecklistItem(ctx, &pb.CreateChecklistItemRequest{
+		TaskId: taskID,
+		Name:   name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Item, nil
+}
+
+// ToggleChecklistItem ticks off or unticks a checklist item
+func (c *Client) ToggleChecklistItem(ctx context.Context, id string, completed bool) (*pb.ChecklistItem, error) {
+	resp, err := c.taskService.ToggleChecklistItem(ctx, &pb.ToggleChecklistItemRequest{
+		Id:        id,
+		Completed: completed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Item, nil
+}
+
+// ReorderChecklistItems puts a task's checklist into the order of itemIDs, which must list every item
+func (c *Client) ReorderChecklistItems(ctx context.Context, taskID string, itemIDs []string) ([]*pb.ChecklistItem, error) {
+	resp, err := c.taskService.ReorderChecklistItems(ctx, &pb.ReorderChecklistItemsRequest{
+		TaskId:  taskID,
+		ItemIds: itemIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
+
+// DeleteChecklistItem deletes a checklist item
+func (c *Client) DeleteChecklistItem(ctx context.Context, id string) error {
+	_, err := c.taskService.DeleteChecklistItem(ctx, &pb.DeleteChecklistItemRequest{
+		Id: id,
+	})
+	return err
+}
+
+// SetTaskRecurrence makes a task repeat by rule, or changes the rule of its series
+func (c *Client) SetTaskRecurrence(ctx context.Context, taskID string, rule *pb.RecurrenceRule) (*pb.Task, error) {
+	resp, err := c.taskService.SetTaskRecurrence(ctx, &pb.SetTaskRecurrenceRequest{
+		TaskId: taskID,
+		Rule:   rule,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// SkipTaskOccurrence moves a recurring task to the dates of its next occurrence
+func (c *Client) SkipTaskOccurrence(ctx context.Context, taskID string) (*pb.Task, error) {
+	resp, err := c.taskService.SkipTaskOccurrence(ctx, &pb.SkipTaskOccurrenceRequest{
+		TaskId: taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// StopTaskRecurrence stops a task from repeating once it is completed
+func (c *Client) StopTaskRecurrence(ctx context.Context, taskID string) (*pb.Task, error) {
+	resp, err := c.taskService.StopTaskRecurrence(ctx, &pb.StopTaskRecurrenceRequest{
+		TaskId: taskID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// AddTaskDependency makes a task depend on another
+func (c *Client) AddTaskDependency(ctx context.Context, taskID, dependsOnID string) (*pb.Task, error) {
+	resp, err := c.taskService.AddTaskDependency(ctx, &pb.AddTaskDependencyRequest{
+		TaskId:      taskID,
+		DependsOnId: dependsOnID,
 	})
 	if err != nil {
 		return nil, err
@@ -205,10 +615,165 @@ func (c *Client) UpdateTask(ctx context.Context, id string, name, notes *string)
 	return resp.Task, nil
 }
 
-// DeleteTask deletes a task
-func (c *Client) DeleteTask(ctx context.Context, id string) error {
-	_, err := c.taskService.DeleteTask(ctx, &pb.DeleteTaskRequest{
+// RemoveTaskDependency removes a dependency between two tasks
+func (c *Client) RemoveTaskDependency(ctx context.Context, taskID, dependsOnID string) (*pb.Task, error) {
+	resp, err := c.taskService.RemoveTaskDependency(ctx, &pb.RemoveTaskDependencyRequest{
+		TaskId:      taskID,
+		DependsOnId: dependsOnID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Task, nil
+}
+
+// CreateTag creates a new tag, nested under parentID if it is not empty
+func (c *Client) CreateTag(ctx context.Context, name, parentID string) (*pb.Tag, error) {
+	resp, err := c.tagService.CreateTag(ctx, &pb.CreateTagRequest{
+		Name:     name,
+		ParentId: parentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tag, nil
+}
+
+// GetTag retrieves a tag by ID
+func (c *Client) GetTag(ctx context.Context, id string) (*pb.Tag, error) {
+	resp, err := c.tagService.GetTag(ctx, &pb.GetTagRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tag, nil
+}
+
+// ListTags lists all tags
+func (c *Client) ListTags(ctx context.Context) ([]*pb.Tag, error) {
+	return collect(c.AllTags(ctx))
+}
+
+// AllTags iterates over all tags, fetching further pages as needed
+func (c *Client) AllTags(ctx context.Context) iter.Seq2[*pb.Tag, error] {
+	return pages(func(pageToken string) ([]*pb.Tag, string, error) {
+		resp, err := c.tagService.ListTags(ctx, &pb.ListTagsRequest{
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Tags, resp.NextPageToken, nil
+	})
+}
+
+// UpdateTag renames a tag or moves it under another tag, as set in req
+func (c *Client) UpdateTag(ctx context.Context, req *pb.UpdateTagRequest) (*pb.Tag, error) {
+	resp, err := c.tagService.UpdateTag(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Tag, nil
+}
+
+// DeleteTag deletes a tag, removing it from all tasks and projects
+func (c *Client) DeleteTag(ctx context.Context, id string) error {
+	_, err := c.tagService.DeleteTag(ctx, &pb.DeleteTagRequest{
 		Id: id,
 	})
 	return err
 }
+
+// ListTrash lists all items in the trash, most recently deleted first
+func (c *Client) ListTrash(ctx context.Context) ([]*pb.TrashItem, error) {
+	return collect(c.AllTrash(ctx))
+}
+
+// AllTrash iterates over all items in the trash, fetching further pages as needed
+func (c *Client) AllTrash(ctx context.Context) iter.Seq2[*pb.TrashItem, error] {
+	return pages(func(pageToken string) ([]*pb.TrashItem, string, error) {
+		resp, err := c.trashService.ListTrash(ctx, &pb.ListTrashRequest{
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+}
+
+// RestoreItem restores an item, and the children deleted along with it, from the trash
+func (c *Client) RestoreItem(ctx context.Context, entityType pb.EntityType, id string) error {
+	_, err := c.trashService.RestoreItem(ctx, &pb.RestoreItemRequest{
+		Type: entityType,
+		Id:   id,
+	})
+	return err
+}
+
+// EmptyTrash permanently deletes everything in the trash
+func (c *Client) EmptyTrash(ctx context.Context) (*pb.EmptyTrashResponse, error) {
+	return c.trashService.EmptyTrash(ctx, &pb.EmptyTrashRequest{})
+}
+
+// Search finds the areas, projects and tasks matching the filters in req, best matches first
+func (c *Client) Search(ctx context.Context, req *pb.SearchRequest) ([]*pb.SearchResult, error) {
+	resp, err := c.searchService.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Results, nil
+}
+
+// CreateBackup backs up the database now
+func (c *Client) CreateBackup(ctx context.Context) (*pb.Backup, error) {
+	resp, err := c.backupService.CreateBackup(ctx, &pb.CreateBackupRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Backup, nil
+}
+
+// ListBackups lists the backups the server has kept, most recent first
+func (c *Client) ListBackups(ctx context.Context) ([]*pb.Backup, error) {
+	resp, err := c.backupService.ListBackups(ctx, &pb.ListBackupsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Backups, nil
+}
+
+// WatchChanges yields batches of changes to areas, projects and tasks made after
+// cursor, or from now if it is empty, limited to the given types if any. It
+// waits for new changes until ctx is cancelled, the server ends the watch, or
+// the caller stops iterating; a failure is yielded as the final error.
+func (c *Client) WatchChanges(ctx context.Context, cursor string, types ...pb.EntityType) iter.Seq2[*pb.WatchChangesResponse, error] {
+	return func(yield func(*pb.WatchChangesResponse, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := c.changeService.WatchChanges(ctx, &pb.WatchChangesRequest{
+			Cursor: cursor,
+			Types:  types,
+		})
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(resp, nil) {
+				return
+			}
+		}
+	}
+}
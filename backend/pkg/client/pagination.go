@@ -0,0 +1,43 @@
+package client
+
+import "iter"
+
+// pages returns an iterator over the items of a paginated List RPC. fetch is
+// called with successive page tokens until the server returns an empty
+// next_page_token. If a fetch fails, the error is yielded and iteration stops.
+func pages[T any](fetch func(pageToken string) ([]T, string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageToken := ""
+		for {
+			items, nextPageToken, err := fetch(pageToken)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if nextPageToken == "" {
+				return
+			}
+			pageToken = nextPageToken
+		}
+	}
+}
+
+// collect gathers every item of a paginated iterator into a slice
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
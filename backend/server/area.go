@@ -29,10 +29,6 @@ func NewAreaService(store *db.Store) *AreaService {
 
 // CreateArea creates a new area
 func (s *AreaService) CreateArea(ctx context.Context, req *pb.CreateAreaRequest) (*pb.CreateAreaResponse, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
-
 	now := time.Now()
 	id := uuid.New().String()
 
@@ -54,10 +50,6 @@ func (s *AreaService) CreateArea(ctx context.Context, req *pb.CreateAreaRequest)
 
 // GetArea retrieves an area by ID
 func (s *AreaService) GetArea(ctx context.Context, req *pb.GetAreaRequest) (*pb.GetAreaResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
-
 	area, err := s.store.Queries.GetArea(ctx, req.Id)
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "area not found: %s", req.Id)
@@ -71,38 +63,42 @@ func (s *AreaService) GetArea(ctx context.Context, req *pb.GetAreaRequest) (*pb.
 	}, nil
 }
 
-// ListAreas lists all areas
+// ListAreas lists areas one page at a time, in manual order unless sorted newest first
 func (s *AreaService) ListAreas(ctx context.Context, req *pb.ListAreasRequest) (*pb.ListAreasResponse, error) {
-	areas, err := s.store.Queries.ListAreas(ctx)
+	page, err := newPageRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	areas, err := s.store.Queries.ListAreas(ctx, db.ListAreasParams{
+		ManualOrder:     manualOrder(req.Order),
+		IncludeArchived: req.IncludeArchived,
+		CursorSortOrder: page.cursorSortOrder(),
+		CursorCreatedAt: page.cursorTime(),
+		CursorID:        page.cursorID(),
+		Limit:           page.fetchLimit(),
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list areas: %v", err)
 	}
 
+	areas, nextPageToken := paginate(page, areas, func(area db.Area) pageCursor {
+		return pageCursor{Time: area.CreatedAt, ID: area.ID, SortOrder: &area.SortOrder}
+	})
+
 	pbAreas := make([]*pb.Area, len(areas))
 	for i, area := range areas {
 		pbAreas[i] = dbAreaToProto(area)
 	}
 
 	return &pb.ListAreasResponse{
-		Areas: pbAreas,
+		Areas:         pbAreas,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // UpdateArea updates an existing area
 func (s *AreaService) UpdateArea(ctx context.Context, req *pb.UpdateAreaRequest) (*pb.UpdateAreaResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
-
-	// Check if area exists
-	exists, err := s.store.Queries.AreaExists(ctx, req.Id)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check area existence: %v", err)
-	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "area not found: %s", req.Id)
-	}
-
 	// Prepare update parameters
 	var name sql.NullString
 	if req.Name != nil {
@@ -114,14 +110,34 @@ func (s *AreaService) UpdateArea(ctx context.Context, req *pb.UpdateAreaRequest)
 		description = sql.NullString{String: *req.Description, Valid: true}
 	}
 
-	area, err := s.store.Queries.UpdateArea(ctx, db.UpdateAreaParams{
-		ID:          req.Id,
-		Name:        name,
-		Description: description,
-		UpdatedAt:   time.Now(),
+	var area db.Area
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if area exists
+		exists, err := q.AreaExists(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "area not found: %s", req.Id)
+		}
+
+		area, err = q.UpdateArea(ctx, db.UpdateAreaParams{
+			ID:              req.Id,
+			Name:            name,
+			Description:     description,
+			UpdatedAt:       time.Now(),
+			ExpectedVersion: nullVersion(req.ExpectedVersion),
+		})
+		if err == sql.ErrNoRows {
+			return conditionalUpdateError("area", req.Id, req.ExpectedVersion)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update area: %v", err)
+		}
+		return nil
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update area: %v", err)
+		return nil, err
 	}
 
 	return &pb.UpdateAreaResponse{
@@ -129,37 +145,180 @@ func (s *AreaService) UpdateArea(ctx context.Context, req *pb.UpdateAreaRequest)
 	}, nil
 }
 
-// DeleteArea deletes an area
+// DeleteArea moves an area to the trash. Depending on the mode, an area that
+// still has projects is refused, trashed along with its projects and tasks, or
+// has its projects moved to another area first.
 func (s *AreaService) DeleteArea(ctx context.Context, req *pb.DeleteAreaRequest) (*pb.DeleteAreaResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
+	deletedAt := sql.NullTime{Time: time.Now(), Valid: true}
+
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		area, err := q.GetArea(ctx, req.Id)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "area not found: %s", req.Id)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get area: %v", err)
+		}
+		if err := checkVersion("area", req.Id, req.ExpectedVersion, area.Version); err != nil {
+			return err
+		}
 
-	// Check if area exists
-	exists, err := s.store.Queries.AreaExists(ctx, req.Id)
+		switch req.Mode {
+		case pb.DeleteMode_DELETE_MODE_CASCADE:
+			// Children share the area's deleted_at so they are restored with it
+			if err := q.TrashTasksInArea(ctx, db.TrashTasksInAreaParams{
+				AreaID:    req.Id,
+				DeletedAt: deletedAt,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete area tasks: %v", err)
+			}
+			if err := q.TrashProjectsInArea(ctx, db.TrashProjectsInAreaParams{
+				AreaID:    req.Id,
+				DeletedAt: deletedAt,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete area projects: %v", err)
+			}
+
+		case pb.DeleteMode_DELETE_MODE_REASSIGN:
+			// Validate that the target area exists
+			targetExists, err := q.AreaExists(ctx, req.ReassignAreaId)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+			}
+			if !targetExists {
+				return status.Errorf(codes.NotFound, "area not found: %s", req.ReassignAreaId)
+			}
+			if err := q.ReassignProjects(ctx, db.ReassignProjectsParams{
+				OldAreaID: req.Id,
+				NewAreaID: req.ReassignAreaId,
+				UpdatedAt: time.Now(),
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to reassign area projects: %v", err)
+			}
+
+		default:
+			projectCount, err := q.CountProjectsInArea(ctx, req.Id)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to count area projects: %v", err)
+			}
+			taskCount, err := q.CountTasksInArea(ctx, req.Id)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to count area tasks: %v", err)
+			}
+			if err := restrictedDeleteError("area", req.Id,
+				childCount{kind: "projects", count: projectCount},
+				childCount{kind: "tasks", count: taskCount},
+			); err != nil {
+				return err
+			}
+		}
+
+		if err := q.TrashArea(ctx, db.TrashAreaParams{
+			ID:        req.Id,
+			DeletedAt: deletedAt,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete area: %v", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+		return nil, err
 	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "area not found: %s", req.Id)
+
+	return &pb.DeleteAreaResponse{
+		Success: true,
+	}, nil
+}
+
+// ReorderArea moves an area directly after after_id and/or before before_id in the manual order
+func (s *AreaService) ReorderArea(ctx context.Context, req *pb.ReorderAreaRequest) (*pb.ReorderAreaResponse, error) {
+	var pbArea *pb.Area
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if err := areaSortOrders(q).reorder(ctx, req.Id, req.AfterId, req.BeforeId); err != nil {
+			return err
+		}
+
+		area, err := q.GetArea(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get area: %v", err)
+		}
+		pbArea = dbAreaToProto(area)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	if err := s.store.Queries.DeleteArea(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete area: %v", err)
+	return &pb.ReorderAreaResponse{
+		Area: pbArea,
+	}, nil
+}
+
+// ArchiveArea archives an area. Its projects and tasks keep their own state
+// but are left out of lists until the area is unarchived.
+func (s *AreaService) ArchiveArea(ctx context.Context, req *pb.ArchiveAreaRequest) (*pb.ArchiveAreaResponse, error) {
+	area, err := s.setArchived(ctx, req.Id, true)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.ArchiveAreaResponse{
+		Area: area,
+	}, nil
+}
 
-	return &pb.DeleteAreaResponse{
-		Success: true,
+// UnarchiveArea unarchives an area
+func (s *AreaService) UnarchiveArea(ctx context.Context, req *pb.UnarchiveAreaRequest) (*pb.UnarchiveAreaResponse, error) {
+	area, err := s.setArchived(ctx, req.Id, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnarchiveAreaResponse{
+		Area: area,
 	}, nil
 }
 
+// setArchived archives or unarchives an area; doing either twice has no further effect
+func (s *AreaService) setArchived(ctx context.Context, id string, archived bool) (*pb.Area, error) {
+	var pbArea *pb.Area
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if area exists
+		exists, err := q.AreaExists(ctx, id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "area not found: %s", id)
+		}
+
+		area, err := q.SetAreaArchived(ctx, db.SetAreaArchivedParams{
+			ID:        id,
+			Archived:  archived,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update area: %v", err)
+		}
+		pbArea = dbAreaToProto(area)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbArea, nil
+}
+
 // dbAreaToProto converts a database area to a protobuf area
 func dbAreaToProto(area db.Area) *pb.Area {
-	return &pb.Area{
+	pbArea := &pb.Area{
 		Id:          area.ID,
 		Name:        area.Name,
 		Description: area.Description.String,
 		CreatedAt:   timestamppb.New(area.CreatedAt),
 		UpdatedAt:   timestamppb.New(area.UpdatedAt),
+		Version:     area.Version,
+	}
+	if area.ArchivedAt.Valid {
+		pbArea.ArchivedAt = timestamppb.New(area.ArchivedAt.Time)
 	}
+	return pbArea
 }
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// BackupService implements the BackupService gRPC service
+type BackupService struct {
+	pb.UnimplementedBackupServiceServer
+	store *db.Store
+
+	// keep is how many backups are kept; zero keeps them all
+	keep int
+
+	// mu stops a scheduled backup and a requested one pruning at the same time
+	mu sync.Mutex
+}
+
+// NewBackupService creates a new BackupService
+func NewBackupService(store *db.Store) *BackupService {
+	return &BackupService{
+		store: store,
+	}
+}
+
+// CreateBackup checks the database's integrity and backs it up now, then
+// removes the oldest backups beyond the number the server keeps
+func (s *BackupService) CreateBackup(ctx context.Context, req *pb.CreateBackupRequest) (*pb.CreateBackupResponse, error) {
+	backup, err := s.backup(ctx)
+	if errors.Is(err, db.ErrBackupsUnsupported) {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	if errors.Is(err, db.ErrIntegrityCheckFailed) {
+		return nil, status.Error(codes.DataLoss, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to back up database: %v", err)
+	}
+
+	return &pb.CreateBackupResponse{
+		Backup: dbBackupToProto(backup),
+	}, nil
+}
+
+// ListBackups lists the backups the server has kept, most recent first
+func (s *BackupService) ListBackups(ctx context.Context, req *pb.ListBackupsRequest) (*pb.ListBackupsResponse, error) {
+	backups, err := s.store.ListBackups()
+	if errors.Is(err, db.ErrBackupsUnsupported) {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list backups: %v", err)
+	}
+
+	pbBackups := make([]*pb.Backup, len(backups))
+	for i, backup := range backups {
+		pbBackups[i] = dbBackupToProto(backup)
+	}
+
+	return &pb.ListBackupsResponse{
+		Backups: pbBackups,
+	}, nil
+}
+
+// backupPeriodically backs up the database once immediately and then every
+// interval until ctx is cancelled
+func (s *BackupService) backupPeriodically(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, err := s.backup(ctx)
+		if errors.Is(err, db.ErrBackupsUnsupported) {
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("Failed to back up database: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// backup takes a backup and prunes the old ones
+func (s *BackupService) backup(ctx context.Context) (db.BackupInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	backup, err := s.store.Backup(ctx)
+	if err != nil {
+		return db.BackupInfo{}, err
+	}
+	if s.keep > 0 {
+		if err := s.store.PruneBackups(s.keep); err != nil {
+			return db.BackupInfo{}, err
+		}
+	}
+	return backup, nil
+}
+
+// dbBackupToProto converts a database backup to its protobuf representation
+func dbBackupToProto(backup db.BackupInfo) *pb.Backup {
+	return &pb.Backup{
+		Name:      backup.Name,
+		Path:      backup.Path,
+		SizeBytes: backup.Size,
+		CreatedAt: timestamppb.New(backup.CreatedAt),
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// BatchCreateTasks creates every task in the request or none of them
+func (s *TaskService) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchCreateTasksResponse, error) {
+	tasks, err := runBatch(ctx, s.store, req.Requests, createTask)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchCreateTasksResponse{
+		Tasks: tasks,
+	}, nil
+}
+
+// BatchUpdateTasks applies every update in the request or none of them
+func (s *TaskService) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchUpdateTasksResponse, error) {
+	tasks, err := runBatch(ctx, s.store, req.Requests, updateTask)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchUpdateTasksResponse{
+		Tasks: tasks,
+	}, nil
+}
+
+// BatchDeleteTasks moves every task in the request to the trash or none of them
+func (s *TaskService) BatchDeleteTasks(ctx context.Context, req *pb.BatchDeleteTasksRequest) (*pb.BatchDeleteTasksResponse, error) {
+	results, err := runBatch(ctx, s.store, req.Requests, func(ctx context.Context, q db.Querier, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
+		if err := deleteTask(ctx, q, req); err != nil {
+			return nil, err
+		}
+		return &pb.DeleteTaskResponse{Success: true}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchDeleteTasksResponse{
+		Results: results,
+	}, nil
+}
+
+// BatchCreateProjects creates every project in the request or none of them
+func (s *ProjectService) BatchCreateProjects(ctx context.Context, req *pb.BatchCreateProjectsRequest) (*pb.BatchCreateProjectsResponse, error) {
+	projects, err := runBatch(ctx, s.store, req.Requests, createProject)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchCreateProjectsResponse{
+		Projects: projects,
+	}, nil
+}
+
+// BatchUpdateProjects applies every update in the request or none of them
+func (s *ProjectService) BatchUpdateProjects(ctx context.Context, req *pb.BatchUpdateProjectsRequest) (*pb.BatchUpdateProjectsResponse, error) {
+	projects, err := runBatch(ctx, s.store, req.Requests, updateProject)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchUpdateProjectsResponse{
+		Projects: projects,
+	}, nil
+}
+
+// BatchDeleteProjects moves every project in the request to the trash or none of them
+func (s *ProjectService) BatchDeleteProjects(ctx context.Context, req *pb.BatchDeleteProjectsRequest) (*pb.BatchDeleteProjectsResponse, error) {
+	results, err := runBatch(ctx, s.store, req.Requests, func(ctx context.Context, q db.Querier, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+		if err := deleteProject(ctx, q, req); err != nil {
+			return nil, err
+		}
+		return &pb.DeleteProjectResponse{Success: true}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchDeleteProjectsResponse{
+		Results: results,
+	}, nil
+}
+
+// runBatch applies each request in turn within a single transaction and
+// returns the results in request order. Every request is attempted, each in
+// its own savepoint, so that a failure reports all the requests that failed
+// rather than just the first; any failure rolls back the whole batch.
+func runBatch[Req, Res any](ctx context.Context, store *db.Store, reqs []Req, apply func(context.Context, db.Querier, Req) (Res, error)) ([]Res, error) {
+	var results []Res
+	err := store.WithTx(ctx, func(q db.Querier) error {
+		results = make([]Res, len(reqs))
+		var failures []batchFailure
+		for i, req := range reqs {
+			err := db.Savepoint(ctx, q, func() error {
+				res, err := apply(ctx, q, req)
+				results[i] = res
+				return err
+			})
+			if err != nil {
+				failures = append(failures, batchFailure{index: i, err: err})
+			}
+		}
+		if len(failures) > 0 {
+			return batchError(failures)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// batchFailure is a request of a batch that failed
+type batchFailure struct {
+	index int
+	err   error
+}
+
+// batchError reports the failed requests of a batch. The status takes its code
+// and details from the first failure, prefixed with the failed request's index,
+// and adds a BadRequest detail listing every failed request and why.
+func batchError(failures []batchFailure) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, failure := range failures {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fmt.Sprintf("requests[%d]", failure.index),
+			Description: status.Convert(failure.err).Message(),
+			Reason:      status.Code(failure.err).String(),
+		})
+	}
+
+	first := failures[0]
+	st, ok := status.FromError(first.err)
+	if !ok {
+		st = status.New(codes.Internal, first.err.Error())
+	}
+	p := st.Proto()
+	p.Message = fmt.Sprintf("requests[%d]: %s", first.index, p.Message)
+	if len(failures) > 1 {
+		p.Message += fmt.Sprintf(" (and %d more failed requests)", len(failures)-1)
+	}
+
+	withDetails, err := status.FromProto(p).WithDetails(badRequest)
+	if err != nil {
+		return status.ErrorProto(p)
+	}
+	return withDetails.Err()
+}
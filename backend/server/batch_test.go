@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// A failing request rolls back the requests before it, and the error lists
+// every request that failed
+func TestBatchCreateTasksFailureRollsBack(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	tasks := NewTaskService(store)
+
+	missing := uuid.NewString()
+	_, err := tasks.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{
+		Requests: []*pb.CreateTaskRequest{
+			{Name: "first"},
+			{Name: "second", ProjectId: missing},
+			{Name: "third"},
+			{Name: "fourth", ProjectId: missing},
+		},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("BatchCreateTasks = %v, want NotFound", err)
+	}
+
+	var violations []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				violations = append(violations, violation.Field)
+			}
+		}
+	}
+	if len(violations) != 2 || violations[0] != "requests[1]" || violations[1] != "requests[3]" {
+		t.Errorf("field violations = %v, want [requests[1] requests[3]]", violations)
+	}
+
+	listed, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(listed.Tasks) != 0 {
+		t.Errorf("ListTasks after failed batch = %d tasks, want 0", len(listed.Tasks))
+	}
+}
+
+// A batch that succeeds applies every request, in order
+func TestBatchCreateTasks(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	tasks := NewTaskService(store)
+
+	resp, err := tasks.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{
+		Requests: []*pb.CreateTaskRequest{{Name: "first"}, {Name: "second"}},
+	})
+	if err != nil {
+		t.Fatalf("BatchCreateTasks: %v", err)
+	}
+	if len(resp.Tasks) != 2 || resp.Tasks[0].Name != "first" || resp.Tasks[1].Name != "second" {
+		t.Errorf("BatchCreateTasks = %v, want first and second", resp.Tasks)
+	}
+}
@@ -0,0 +1,238 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Change operation values as stored in the database
+const (
+	changeOperationCreate = "create"
+	changeOperationUpdate = "update"
+	changeOperationDelete = "delete"
+)
+
+const (
+	// changeBatchSize is the most changes sent in a single response
+	changeBatchSize = 500
+
+	// changePollInterval is how often watchers look for changes made by other
+	// processes sharing the database, which do not wake them directly
+	changePollInterval = time.Second
+
+	// changeRetention is how many of the most recent changes are kept for
+	// watchers to resume from, and changePruneInterval how often older ones
+	// are deleted
+	changeRetention     = 10000
+	changePruneInterval = time.Hour
+)
+
+// ChangeService implements the ChangeService gRPC service. Changes are
+// recorded by database triggers; the service only reads them back.
+type ChangeService struct {
+	pb.UnimplementedChangeServiceServer
+	store    *db.Store
+	notifier *changeNotifier
+
+	// done is closed by stop to end every open watch
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewChangeService creates a new ChangeService
+func NewChangeService(store *db.Store) *ChangeService {
+	return &ChangeService{
+		store:    store,
+		notifier: newChangeNotifier(),
+		done:     make(chan struct{}),
+	}
+}
+
+// WatchChanges streams the changes made after the request's cursor, then
+// waits for more until the client goes away or the server stops
+func (s *ChangeService) WatchChanges(req *pb.WatchChangesRequest, stream grpc.ServerStreamingServer[pb.WatchChangesResponse]) error {
+	ctx := stream.Context()
+
+	bounds, err := s.store.Queries.GetChangeBounds(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get change log bounds: %v", err)
+	}
+
+	after := bounds.LatestID
+	if req.Cursor != "" {
+		after, err = strconv.ParseInt(req.Cursor, 10, 64)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid cursor: %s", req.Cursor)
+		}
+		if after < bounds.OldestID-1 || after > bounds.LatestID {
+			return status.Errorf(codes.FailedPrecondition, "cursor %s is no longer in the change log", req.Cursor)
+		}
+	}
+
+	var types map[string]bool
+	if len(req.Types) > 0 {
+		types = make(map[string]bool, len(req.Types))
+		for _, entityType := range req.Types {
+			types[entityTypeToDB(entityType)] = true
+		}
+	}
+
+	ticker := time.NewTicker(changePollInterval)
+	defer ticker.Stop()
+
+	first := true
+	for {
+		// Take the wake-up channel before reading so that a change committed
+		// in between still wakes us
+		changed := s.notifier.wait()
+
+		changes, err := s.store.Queries.ListChanges(ctx, db.ListChangesParams{
+			AfterID: after,
+			Limit:   changeBatchSize,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list changes: %v", err)
+		}
+
+		resp := &pb.WatchChangesResponse{}
+		for _, change := range changes {
+			after = change.ID
+			if types == nil || types[change.EntityType] {
+				resp.Changes = append(resp.Changes, dbChangeToProto(change))
+			}
+		}
+
+		// The first response is sent even when empty so the client learns
+		// where it is starting from
+		if first || len(resp.Changes) > 0 {
+			resp.Cursor = formatChangeCursor(after)
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			first = false
+		}
+
+		if len(changes) == changeBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-s.done:
+			return nil
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// stop ends every open watch so the gRPC server can stop gracefully
+func (s *ChangeService) stop() {
+	s.stopOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// pruneChanges trims the change log to the most recent changeRetention
+// entries, once immediately and then every changePruneInterval until ctx is
+// cancelled
+func (s *ChangeService) pruneChanges(ctx context.Context) {
+	ticker := time.NewTicker(changePruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.store.Queries.PruneChanges(ctx, changeRetention); err != nil && ctx.Err() == nil {
+			fmt.Printf("Failed to prune change log: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// changeNotifier wakes watchers when a request may have written something.
+// Each wait returns a channel that is closed by the next notify.
+type changeNotifier struct {
+	mu sync.Mutex
+	ch chan struct{}
+}
+
+// newChangeNotifier creates a changeNotifier with nothing waiting
+func newChangeNotifier() *changeNotifier {
+	return &changeNotifier{
+		ch: make(chan struct{}),
+	}
+}
+
+// wait returns a channel that is closed the next time notify is called
+func (n *changeNotifier) wait() <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.ch
+}
+
+// notify wakes everything waiting
+func (n *changeNotifier) notify() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	close(n.ch)
+	n.ch = make(chan struct{})
+}
+
+// changeNotifyUnaryInterceptor wakes watchers after every successful unary
+// call. Reads wake them too, which costs each watcher one cheap query, but
+// saves every write handler from having to remember to do it.
+func changeNotifyUnaryInterceptor(notifier *changeNotifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			notifier.notify()
+		}
+		return resp, err
+	}
+}
+
+// formatChangeCursor encodes a change log id as a cursor
+func formatChangeCursor(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// dbChangeToProto converts a database change to its protobuf representation
+func dbChangeToProto(change db.Change) *pb.Change {
+	return &pb.Change{
+		Cursor:    formatChangeCursor(change.ID),
+		Type:      entityTypeFromDB(change.EntityType),
+		Id:        change.EntityID,
+		Operation: changeOperationFromDB(change.Operation),
+		ChangedAt: timestamppb.New(change.ChangedAt),
+	}
+}
+
+// changeOperationFromDB converts a database change operation to its protobuf representation
+func changeOperationFromDB(operation string) pb.ChangeOperation {
+	switch operation {
+	case changeOperationCreate:
+		return pb.ChangeOperation_CHANGE_OPERATION_CREATE
+	case changeOperationUpdate:
+		return pb.ChangeOperation_CHANGE_OPERATION_UPDATE
+	case changeOperationDelete:
+		return pb.ChangeOperation_CHANGE_OPERATION_DELETE
+	default:
+		return pb.ChangeOperation_CHANGE_OPERATION_UNSPECIFIED
+	}
+}
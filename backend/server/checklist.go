@@ -0,0 +1,211 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// CreateChecklistItem adds an item to the end of a task's checklist
+func (s *TaskService) CreateChecklistItem(ctx context.Context, req *pb.CreateChecklistItemRequest) (*pb.CreateChecklistItemResponse, error) {
+	var item db.ChecklistItem
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if task exists
+		exists, err := q.TaskExists(ctx, req.TaskId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.TaskId)
+		}
+
+		position, err := q.NextChecklistPosition(ctx, req.TaskId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get checklist position: %v", err)
+		}
+
+		now := time.Now()
+		item, err = q.CreateChecklistItem(ctx, db.CreateChecklistItemParams{
+			ID:        uuid.New().String(),
+			TaskID:    req.TaskId,
+			Name:      req.Name,
+			Position:  position,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create checklist item: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateChecklistItemResponse{
+		Item: dbChecklistItemToProto(item),
+	}, nil
+}
+
+// ToggleChecklistItem ticks off or unticks a checklist item
+func (s *TaskService) ToggleChecklistItem(ctx context.Context, req *pb.ToggleChecklistItemRequest) (*pb.ToggleChecklistItemResponse, error) {
+	var item db.ChecklistItem
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if the item exists
+		if _, err := q.GetChecklistItem(ctx, req.Id); err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "checklist item not found: %s", req.Id)
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "failed to get checklist item: %v", err)
+		}
+
+		var err error
+		item, err = q.SetChecklistItemCompleted(ctx, db.SetChecklistItemCompletedParams{
+			ID:        req.Id,
+			Completed: req.Completed,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update checklist item: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ToggleChecklistItemResponse{
+		Item: dbChecklistItemToProto(item),
+	}, nil
+}
+
+// ReorderChecklistItems puts a task's checklist into the given order. The
+// request must list every item of the checklist exactly once.
+func (s *TaskService) ReorderChecklistItems(ctx context.Context, req *pb.ReorderChecklistItemsRequest) (*pb.ReorderChecklistItemsResponse, error) {
+	var pbItems []*pb.ChecklistItem
+
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		pbItems = make([]*pb.ChecklistItem, 0, len(req.ItemIds))
+
+		// Check if task exists
+		exists, err := q.TaskExists(ctx, req.TaskId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.TaskId)
+		}
+
+		items, err := q.ListChecklistItems(ctx, []string{req.TaskId})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list checklist items: %v", err)
+		}
+
+		byID := make(map[string]db.ChecklistItem, len(items))
+		for _, item := range items {
+			byID[item.ID] = item
+		}
+		if len(req.ItemIds) != len(items) {
+			return status.Errorf(codes.FailedPrecondition, "task %s has %d checklist items but %d were given", req.TaskId, len(items), len(req.ItemIds))
+		}
+
+		now := time.Now()
+		for position, id := range req.ItemIds {
+			item, ok := byID[id]
+			if !ok {
+				return status.Errorf(codes.NotFound, "checklist item not found in task %s: %s", req.TaskId, id)
+			}
+			if err := q.SetChecklistItemPosition(ctx, db.SetChecklistItemPositionParams{
+				ID:        id,
+				Position:  int64(position),
+				UpdatedAt: now,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to reorder checklist item %s: %v", id, err)
+			}
+
+			item.Position = int64(position)
+			item.UpdatedAt = now
+			pbItems = append(pbItems, dbChecklistItemToProto(item))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderChecklistItemsResponse{
+		Items: pbItems,
+	}, nil
+}
+
+// DeleteChecklistItem permanently deletes a checklist item
+func (s *TaskService) DeleteChecklistItem(ctx context.Context, req *pb.DeleteChecklistItemRequest) (*pb.DeleteChecklistItemResponse, error) {
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if the item exists
+		if _, err := q.GetChecklistItem(ctx, req.Id); err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "checklist item not found: %s", req.Id)
+		} else if err != nil {
+			return status.Errorf(codes.Internal, "failed to get checklist item: %v", err)
+		}
+
+		if err := q.DeleteChecklistItem(ctx, req.Id); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete checklist item: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteChecklistItemResponse{
+		Success: true,
+	}, nil
+}
+
+// withTaskChecklists fills in the checklist and progress counts of the given
+// tasks with a single query
+func withTaskChecklists(ctx context.Context, q db.Querier, tasks ...*pb.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Task, len(tasks))
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		byID[task.Id] = task
+		ids[i] = task.Id
+	}
+
+	items, err := q.ListChecklistItems(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list checklist items: %v", err)
+	}
+	for _, item := range items {
+		task := byID[item.TaskID]
+		task.Checklist = append(task.Checklist, dbChecklistItemToProto(item))
+		task.ChecklistTotal++
+		if item.Completed {
+			task.ChecklistCompleted++
+		}
+	}
+	return nil
+}
+
+// dbChecklistItemToProto converts a database checklist item to a protobuf checklist item
+func dbChecklistItemToProto(item db.ChecklistItem) *pb.ChecklistItem {
+	return &pb.ChecklistItem{
+		Id:        item.ID,
+		TaskId:    item.TaskID,
+		Name:      item.Name,
+		Completed: item.Completed,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// childCount is the number of children of one kind that belong to an item
+type childCount struct {
+	kind  string
+	count int64
+}
+
+// restrictedDeleteError returns a FailedPrecondition status listing the children
+// that prevent an item from being deleted, or nil if it has none
+func restrictedDeleteError(kind, id string, children ...childCount) error {
+	failure := &errdetails.PreconditionFailure{}
+	var parts []string
+	for _, child := range children {
+		if child.count == 0 {
+			continue
+		}
+		description := fmt.Sprintf("%d %s", child.count, child.kind)
+		parts = append(parts, description)
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "HAS_CHILDREN",
+			Subject:     child.kind,
+			Description: description,
+		})
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+
+	msg := fmt.Sprintf("%s %s still has %s; delete with cascade or reassign mode", kind, id, strings.Join(parts, " and "))
+	st, err := status.New(codes.FailedPrecondition, msg).WithDetails(failure)
+	if err != nil {
+		return status.Error(codes.FailedPrecondition, msg)
+	}
+	return st.Err()
+}
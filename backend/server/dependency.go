@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// AddTaskDependency makes a task depend on another. Tasks may depend on tasks in
+// any project, but dependencies may not form a cycle.
+func (s *TaskService) AddTaskDependency(ctx context.Context, req *pb.AddTaskDependencyRequest) (*pb.AddTaskDependencyResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		task, err := q.GetTask(ctx, req.TaskId)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.TaskId)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get task: %v", err)
+		}
+
+		// Validate that the dependency exists
+		exists, err := q.TaskExists(ctx, req.DependsOnId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.DependsOnId)
+		}
+
+		if err := checkDependencyCycle(ctx, q, req.TaskId, req.DependsOnId); err != nil {
+			return err
+		}
+
+		if err := q.AddTaskDependency(ctx, db.AddTaskDependencyParams{
+			TaskID:      req.TaskId,
+			DependsOnID: req.DependsOnId,
+			CreatedAt:   time.Now(),
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to add task dependency: %v", err)
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddTaskDependencyResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// RemoveTaskDependency removes a dependency between two tasks
+func (s *TaskService) RemoveTaskDependency(ctx context.Context, req *pb.RemoveTaskDependencyRequest) (*pb.RemoveTaskDependencyResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		task, err := q.GetTask(ctx, req.TaskId)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.TaskId)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get task: %v", err)
+		}
+
+		removed, err := q.DeleteTaskDependency(ctx, db.DeleteTaskDependencyParams{
+			TaskID:      req.TaskId,
+			DependsOnID: req.DependsOnId,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to remove task dependency: %v", err)
+		}
+		if removed == 0 {
+			return status.Errorf(codes.NotFound, "task %s does not depend on %s", req.TaskId, req.DependsOnId)
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveTaskDependencyResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// checkDependencyCycle returns FailedPrecondition if taskID is already a direct
+// or indirect dependency of dependsOnID, so that adding the edge would form a cycle
+func checkDependencyCycle(ctx context.Context, q db.Querier, taskID, dependsOnID string) error {
+	// Walk the dependency graph breadth first, one query per level
+	visited := map[string]bool{dependsOnID: true}
+	frontier := []string{dependsOnID}
+	for len(frontier) > 0 {
+		edges, err := q.ListDependencyEdges(ctx, frontier)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list task dependencies: %v", err)
+		}
+
+		frontier = frontier[:0]
+		for _, edge := range edges {
+			if edge.DependsOnID == taskID {
+				return status.Errorf(codes.FailedPrecondition, "task %s already depends on %s; the dependency would form a cycle", dependsOnID, taskID)
+			}
+			if !visited[edge.DependsOnID] {
+				visited[edge.DependsOnID] = true
+				frontier = append(frontier, edge.DependsOnID)
+			}
+		}
+	}
+	return nil
+}
+
+// withTaskDependencies fills in the dependencies and open blockers of the given
+// tasks with a single query
+func withTaskDependencies(ctx context.Context, q db.Querier, tasks ...*pb.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Task, len(tasks))
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		byID[task.Id] = task
+		ids[i] = task.Id
+	}
+
+	rows, err := q.ListTaskDependencies(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list task dependencies: %v", err)
+	}
+	for _, row := range rows {
+		task := byID[row.TaskID]
+		task.DependsOnIds = append(task.DependsOnIds, row.DependsOnID)
+		if row.Status == taskStatusOpen {
+			task.BlockedByIds = append(task.BlockedByIds, row.DependsOnID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+func TestAddTaskDependencyCycles(t *testing.T) {
+	// edge makes the first task depend on the second
+	type edge [2]string
+
+	tests := []struct {
+		name     string
+		existing []edge
+		add      edge
+		wantCode codes.Code
+	}{
+		{name: "first dependency", add: edge{"a", "b"}},
+		{name: "direct cycle", existing: []edge{{"b", "a"}}, add: edge{"a", "b"}, wantCode: codes.FailedPrecondition},
+		{name: "indirect cycle", existing: []edge{{"a", "b"}, {"b", "c"}}, add: edge{"c", "a"}, wantCode: codes.FailedPrecondition},
+		{
+			name:     "long chain cycle",
+			existing: []edge{{"a", "b"}, {"b", "c"}, {"c", "d"}, {"d", "e"}},
+			add:      edge{"e", "a"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "chain without cycle", existing: []edge{{"a", "b"}, {"b", "c"}}, add: edge{"a", "c"}},
+		{name: "shared dependency", existing: []edge{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}}, add: edge{"a", "d"}},
+		{
+			name:     "cycle through a diamond",
+			existing: []edge{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+			add:      edge{"d", "a"},
+			wantCode: codes.FailedPrecondition,
+		},
+		{name: "joining separate chains", existing: []edge{{"a", "b"}, {"c", "d"}}, add: edge{"b", "c"}},
+		{name: "missing dependency", add: edge{"a", "missing"}, wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tasks := NewTaskService(newTestStore(t))
+
+			ids := map[string]string{"missing": uuid.NewString()}
+			for _, name := range []string{"a", "b", "c", "d", "e"} {
+				created, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: name})
+				if err != nil {
+					t.Fatalf("CreateTask: %v", err)
+				}
+				ids[name] = created.Task.Id
+			}
+			addDependency := func(e edge) error {
+				_, err := tasks.AddTaskDependency(ctx, &pb.AddTaskDependencyRequest{TaskId: ids[e[0]], DependsOnId: ids[e[1]]})
+				return err
+			}
+
+			for _, e := range tt.existing {
+				if err := addDependency(e); err != nil {
+					t.Fatalf("AddTaskDependency %v: %v", e, err)
+				}
+			}
+			if err := addDependency(tt.add); status.Code(err) != tt.wantCode {
+				t.Errorf("AddTaskDependency %v = %v, want %v", tt.add, err, tt.wantCode)
+			}
+		})
+	}
+}
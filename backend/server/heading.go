@@ -0,0 +1,291 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// GetProjectOutline returns a project's tasks grouped under its headings, with
+// the tasks that have no heading listed separately
+func (s *ProjectService) GetProjectOutline(ctx context.Context, req *pb.GetProjectOutlineRequest) (*pb.GetProjectOutlineResponse, error) {
+	var resp *pb.GetProjectOutlineResponse
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		resp = &pb.GetProjectOutlineResponse{}
+		project, err := q.GetProject(ctx, req.ProjectId)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get project: %v", err)
+		}
+		resp.Project = dbProjectToProto(project)
+		if err := withProjectDetails(ctx, q, resp.Project); err != nil {
+			return err
+		}
+
+		headings, err := q.ListHeadings(ctx, req.ProjectId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list headings: %v", err)
+		}
+		sections := make(map[string]*pb.HeadingSection, len(headings))
+		for _, heading := range headings {
+			section := &pb.HeadingSection{Heading: dbHeadingToProto(heading)}
+			sections[heading.ID] = section
+			resp.Sections = append(resp.Sections, section)
+		}
+
+		tasks, err := q.ListProjectTasks(ctx, db.ListProjectTasksParams{
+			ProjectID:       sql.NullString{String: req.ProjectId, Valid: true},
+			IncludeFinished: req.IncludeFinished,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list project tasks: %v", err)
+		}
+
+		pbTasks := make([]*pb.Task, len(tasks))
+		for i, task := range tasks {
+			pbTasks[i] = dbTaskToProto(task)
+			if section, ok := sections[task.HeadingID.String]; ok {
+				section.Tasks = append(section.Tasks, pbTasks[i])
+			} else {
+				resp.Tasks = append(resp.Tasks, pbTasks[i])
+			}
+		}
+		return withTaskDetails(ctx, q, pbTasks...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateHeading adds a heading to the end of a project
+func (s *ProjectService) CreateHeading(ctx context.Context, req *pb.CreateHeadingRequest) (*pb.CreateHeadingResponse, error) {
+	var heading db.Heading
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Validate that the project exists
+		projectExists, err := q.ProjectExists(ctx, req.ProjectId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+		}
+
+		now := time.Now()
+		heading, err = q.CreateHeading(ctx, db.CreateHeadingParams{
+			ID:        uuid.New().String(),
+			ProjectID: req.ProjectId,
+			Name:      req.Name,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create heading: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateHeadingResponse{
+		Heading: dbHeadingToProto(heading),
+	}, nil
+}
+
+// RenameHeading renames a heading
+func (s *ProjectService) RenameHeading(ctx context.Context, req *pb.RenameHeadingRequest) (*pb.RenameHeadingResponse, error) {
+	var heading db.Heading
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if _, err := getHeading(ctx, q, req.Id); err != nil {
+			return err
+		}
+
+		var err error
+		heading, err = q.RenameHeading(ctx, db.RenameHeadingParams{
+			ID:        req.Id,
+			Name:      req.Name,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to rename heading: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RenameHeadingResponse{
+		Heading: dbHeadingToProto(heading),
+	}, nil
+}
+
+// ReorderHeading moves a heading directly after after_id and/or before before_id
+func (s *ProjectService) ReorderHeading(ctx context.Context, req *pb.ReorderHeadingRequest) (*pb.ReorderHeadingResponse, error) {
+	var heading db.Heading
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		current, err := getHeading(ctx, q, req.Id)
+		if err != nil {
+			return err
+		}
+
+		// Neighbours must be headings of the same project
+		for _, neighbourID := range []string{req.AfterId, req.BeforeId} {
+			if neighbourID == "" {
+				continue
+			}
+			neighbour, err := getHeading(ctx, q, neighbourID)
+			if err != nil {
+				return err
+			}
+			if neighbour.ProjectID != current.ProjectID {
+				return status.Errorf(codes.FailedPrecondition, "heading %s belongs to another project", neighbourID)
+			}
+		}
+
+		if err := headingSortOrders(q).reorder(ctx, req.Id, req.AfterId, req.BeforeId); err != nil {
+			return err
+		}
+
+		heading, err = q.GetHeading(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get heading: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderHeadingResponse{
+		Heading: dbHeadingToProto(heading),
+	}, nil
+}
+
+// MoveHeading moves a heading, together with all of its tasks, to the end of another project
+func (s *ProjectService) MoveHeading(ctx context.Context, req *pb.MoveHeadingRequest) (*pb.MoveHeadingResponse, error) {
+	var heading db.Heading
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		var err error
+		heading, err = getHeading(ctx, q, req.Id)
+		if err != nil {
+			return err
+		}
+		if heading.ProjectID == req.ProjectId {
+			return nil
+		}
+
+		// Validate that the destination project exists
+		projectExists, err := q.ProjectExists(ctx, req.ProjectId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+		}
+
+		now := time.Now()
+		heading, err = q.MoveHeading(ctx, db.MoveHeadingParams{
+			ID:        req.Id,
+			ProjectID: req.ProjectId,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to move heading: %v", err)
+		}
+		if err := q.MoveHeadingTasks(ctx, db.MoveHeadingTasksParams{
+			HeadingID: sql.NullString{String: req.Id, Valid: true},
+			ProjectID: sql.NullString{String: req.ProjectId, Valid: true},
+			UpdatedAt: now,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to move heading tasks: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveHeadingResponse{
+		Heading: dbHeadingToProto(heading),
+	}, nil
+}
+
+// DeleteHeading permanently deletes a heading. Its tasks stay in the project
+// without a heading.
+func (s *ProjectService) DeleteHeading(ctx context.Context, req *pb.DeleteHeadingRequest) (*pb.DeleteHeadingResponse, error) {
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if _, err := getHeading(ctx, q, req.Id); err != nil {
+			return err
+		}
+
+		if err := q.ClearTaskHeadings(ctx, db.ClearTaskHeadingsParams{
+			HeadingID: sql.NullString{String: req.Id, Valid: true},
+			UpdatedAt: time.Now(),
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to clear task headings: %v", err)
+		}
+		if err := q.DeleteHeading(ctx, req.Id); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete heading: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteHeadingResponse{
+		Success: true,
+	}, nil
+}
+
+// getHeading retrieves a heading, returning NotFound if it or its project does not exist
+func getHeading(ctx context.Context, q db.Querier, id string) (db.Heading, error) {
+	heading, err := q.GetHeading(ctx, id)
+	if err == sql.ErrNoRows {
+		return db.Heading{}, status.Errorf(codes.NotFound, "heading not found: %s", id)
+	}
+	if err != nil {
+		return db.Heading{}, status.Errorf(codes.Internal, "failed to get heading: %v", err)
+	}
+	return heading, nil
+}
+
+// checkTaskHeading validates that a heading exists and belongs to the project a
+// task is in (or is being moved to); tasks in the Inbox cannot have a heading
+func checkTaskHeading(ctx context.Context, q db.Querier, headingID string, projectID sql.NullString) error {
+	if !projectID.Valid {
+		return status.Errorf(codes.FailedPrecondition, "tasks in the Inbox cannot have a heading")
+	}
+
+	heading, err := getHeading(ctx, q, headingID)
+	if err != nil {
+		return err
+	}
+	if heading.ProjectID != projectID.String {
+		return status.Errorf(codes.FailedPrecondition, "heading %s belongs to project %s, not %s", headingID, heading.ProjectID, projectID.String)
+	}
+	return nil
+}
+
+// dbHeadingToProto converts a database heading to a protobuf heading
+func dbHeadingToProto(heading db.Heading) *pb.Heading {
+	return &pb.Heading{
+		Id:        heading.ID,
+		ProjectId: heading.ProjectID,
+		Name:      heading.Name,
+		CreatedAt: timestamppb.New(heading.CreatedAt),
+		UpdatedAt: timestamppb.New(heading.UpdatedAt),
+	}
+}
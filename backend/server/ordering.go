@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// sortOrderGap is the distance between neighbouring sort keys when placing an
+// item at either end of a list, or after renumbering
+const sortOrderGap = 1
+
+// sortOrderTable holds the sort_order queries of one table, so that areas,
+// projects, tasks and headings share the logic for placing an item between two others.
+// Items are ordered within their list: the project (or Inbox) of a task, the
+// area of a project and the project of a heading. Areas form a single list.
+type sortOrderTable struct {
+	entity string
+	parent string
+	get    func(ctx context.Context, id string) (sortPosition, error)
+	after  func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error)
+	before func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error)
+	ids    func(ctx context.Context, list sql.NullString) ([]string, error)
+	set    func(ctx context.Context, id string, sortOrder float64, updatedAt time.Time) error
+}
+
+// sortPosition is an item's sort key and the list it is ordered within
+type sortPosition struct {
+	sortOrder float64
+	list      sql.NullString
+}
+
+// areaSortOrders returns the sort_order queries of the areas table
+func areaSortOrders(q db.Querier) sortOrderTable {
+	return sortOrderTable{
+		entity: "area",
+		get: func(ctx context.Context, id string) (sortPosition, error) {
+			area, err := q.GetArea(ctx, id)
+			return sortPosition{sortOrder: area.SortOrder}, err
+		},
+		after: func(ctx context.Context, _ sql.NullString, sortOrder float64) (float64, error) {
+			return q.AreaSortOrderAfter(ctx, sortOrder)
+		},
+		before: func(ctx context.Context, _ sql.NullString, sortOrder float64) (float64, error) {
+			return q.AreaSortOrderBefore(ctx, sortOrder)
+		},
+		ids: func(ctx context.Context, _ sql.NullString) ([]string, error) {
+			return q.ListAreaIDsBySortOrder(ctx)
+		},
+		set: func(ctx context.Context, id string, sortOrder float64, updatedAt time.Time) error {
+			return q.SetAreaSortOrder(ctx, db.SetAreaSortOrderParams{ID: id, SortOrder: sortOrder, UpdatedAt: updatedAt})
+		},
+	}
+}
+
+// projectSortOrders returns the sort_order queries of the projects table
+func projectSortOrders(q db.Querier) sortOrderTable {
+	return sortOrderTable{
+		entity: "project",
+		parent: "area",
+		get: func(ctx context.Context, id string) (sortPosition, error) {
+			project, err := q.GetProject(ctx, id)
+			return sortPosition{sortOrder: project.SortOrder, list: sql.NullString{String: project.AreaID, Valid: true}}, err
+		},
+		after: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.ProjectSortOrderAfter(ctx, db.ProjectSortOrderAfterParams{SortOrder: sortOrder, AreaID: list.String})
+		},
+		before: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.ProjectSortOrderBefore(ctx, db.ProjectSortOrderBeforeParams{SortOrder: sortOrder, AreaID: list.String})
+		},
+		ids: func(ctx context.Context, list sql.NullString) ([]string, error) {
+			return q.ListProjectIDsBySortOrder(ctx, list.String)
+		},
+		set: func(ctx context.Context, id string, sortOrder float64, updatedAt time.Time) error {
+			return q.SetProjectSortOrder(ctx, db.SetProjectSortOrderParams{ID: id, SortOrder: sortOrder, UpdatedAt: updatedAt})
+		},
+	}
+}
+
+// taskSortOrders returns the sort_order queries of the tasks table
+func taskSortOrders(q db.Querier) sortOrderTable {
+	return sortOrderTable{
+		entity: "task",
+		parent: "project",
+		get: func(ctx context.Context, id string) (sortPosition, error) {
+			task, err := q.GetTask(ctx, id)
+			return sortPosition{sortOrder: task.SortOrder, list: task.ProjectID}, err
+		},
+		after: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.TaskSortOrderAfter(ctx, db.TaskSortOrderAfterParams{SortOrder: sortOrder, ProjectID: list})
+		},
+		before: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.TaskSortOrderBefore(ctx, db.TaskSortOrderBeforeParams{SortOrder: sortOrder, ProjectID: list})
+		},
+		ids: q.ListTaskIDsBySortOrder,
+		set: func(ctx context.Context, id string, sortOrder float64, updatedAt time.Time) error {
+			return q.SetTaskSortOrder(ctx, db.SetTaskSortOrderParams{ID: id, SortOrder: sortOrder, UpdatedAt: updatedAt})
+		},
+	}
+}
+
+// headingSortOrders returns the sort_order queries of the headings table
+func headingSortOrders(q db.Querier) sortOrderTable {
+	return sortOrderTable{
+		entity: "heading",
+		parent: "project",
+		get: func(ctx context.Context, id string) (sortPosition, error) {
+			heading, err := q.GetHeading(ctx, id)
+			return sortPosition{sortOrder: heading.SortOrder, list: sql.NullString{String: heading.ProjectID, Valid: true}}, err
+		},
+		after: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.HeadingSortOrderAfter(ctx, db.HeadingSortOrderAfterParams{SortOrder: sortOrder, ProjectID: list.String})
+		},
+		before: func(ctx context.Context, list sql.NullString, sortOrder float64) (float64, error) {
+			return q.HeadingSortOrderBefore(ctx, db.HeadingSortOrderBeforeParams{SortOrder: sortOrder, ProjectID: list.String})
+		},
+		ids: func(ctx context.Context, list sql.NullString) ([]string, error) {
+			return q.ListHeadingIDsBySortOrder(ctx, list.String)
+		},
+		set: func(ctx context.Context, id string, sortOrder float64, updatedAt time.Time) error {
+			return q.SetHeadingSortOrder(ctx, db.SetHeadingSortOrderParams{ID: id, SortOrder: sortOrder, UpdatedAt: updatedAt})
+		},
+	}
+}
+
+// reorder moves an item directly after afterID and/or directly before beforeID
+// by giving it the sort key halfway between its new neighbours, which must be
+// in the same list. Only when the neighbours' keys are too close to split is
+// the list renumbered.
+func (t sortOrderTable) reorder(ctx context.Context, id, afterID, beforeID string) error {
+	item, err := t.lookup(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	lower, upper, err := t.bounds(ctx, item.list, afterID, beforeID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	sortOrder := lower + (upper-lower)/2
+	if sortOrder <= lower || sortOrder >= upper {
+		if err := t.renumber(ctx, item.list, now); err != nil {
+			return err
+		}
+		if lower, upper, err = t.bounds(ctx, item.list, afterID, beforeID); err != nil {
+			return err
+		}
+		sortOrder = lower + (upper-lower)/2
+	}
+
+	if err := t.set(ctx, id, sortOrder, now); err != nil {
+		return status.Errorf(codes.Internal, "failed to reorder %s: %v", t.entity, err)
+	}
+	return nil
+}
+
+// bounds returns the sort keys an item of list must fall strictly between to
+// be placed after afterID and before beforeID. A missing neighbour is taken to
+// be the adjacent item in the list, or a gap past the end of the list.
+func (t sortOrderTable) bounds(ctx context.Context, list sql.NullString, afterID, beforeID string) (float64, float64, error) {
+	var lower, upper float64
+
+	if afterID != "" {
+		neighbour, err := t.neighbour(ctx, list, afterID)
+		if err != nil {
+			return 0, 0, err
+		}
+		lower = neighbour.sortOrder
+	}
+	if beforeID != "" {
+		neighbour, err := t.neighbour(ctx, list, beforeID)
+		if err != nil {
+			return 0, 0, err
+		}
+		upper = neighbour.sortOrder
+	}
+
+	var err error
+	switch {
+	case afterID != "" && beforeID != "":
+		if upper <= lower {
+			return 0, 0, status.Errorf(codes.FailedPrecondition, "%s %s does not come after %s", t.entity, beforeID, afterID)
+		}
+	case afterID != "":
+		upper, err = t.after(ctx, list, lower)
+		if err == sql.ErrNoRows {
+			upper = lower + 2*sortOrderGap
+		} else if err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "failed to get %s sort order: %v", t.entity, err)
+		}
+	default:
+		lower, err = t.before(ctx, list, upper)
+		if err == sql.ErrNoRows {
+			lower = upper - 2*sortOrderGap
+		} else if err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "failed to get %s sort order: %v", t.entity, err)
+		}
+	}
+	return lower, upper, nil
+}
+
+// neighbour returns the position of an item to be placed next to, or
+// FailedPrecondition if it is not in list
+func (t sortOrderTable) neighbour(ctx context.Context, list sql.NullString, id string) (sortPosition, error) {
+	neighbour, err := t.lookup(ctx, id)
+	if err != nil {
+		return sortPosition{}, err
+	}
+	if neighbour.list != list {
+		return sortPosition{}, status.Errorf(codes.FailedPrecondition, "%s %s is not in the same %s", t.entity, id, t.parent)
+	}
+	return neighbour, nil
+}
+
+// lookup returns the position of an item, or NotFound if it does not exist
+func (t sortOrderTable) lookup(ctx context.Context, id string) (sortPosition, error) {
+	position, err := t.get(ctx, id)
+	if err == sql.ErrNoRows {
+		return sortPosition{}, status.Errorf(codes.NotFound, "%s not found: %s", t.entity, id)
+	}
+	if err != nil {
+		return sortPosition{}, status.Errorf(codes.Internal, "failed to get %s: %v", t.entity, err)
+	}
+	return position, nil
+}
+
+// renumber spreads the sort keys of a list out evenly, keeping their order.
+// Trashed items and other lists keep their keys.
+func (t sortOrderTable) renumber(ctx context.Context, list sql.NullString, updatedAt time.Time) error {
+	ids, err := t.ids(ctx, list)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list %s sort order: %v", t.entity, err)
+	}
+	for i, id := range ids {
+		if err := t.set(ctx, id, float64(i*sortOrderGap), updatedAt); err != nil {
+			return status.Errorf(codes.Internal, "failed to renumber %s sort order: %v", t.entity, err)
+		}
+	}
+	return nil
+}
+
+// manualOrder reports whether a List request should be sorted in manual order,
+// which is the default
+func manualOrder(order pb.ListOrder) bool {
+	return order != pb.ListOrder_LIST_ORDER_CREATED
+}
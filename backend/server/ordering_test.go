@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// orderingFixture is a project with tasks a to d, which list newest first as
+// d c b a, a trashed task in the project and a task in another project
+type orderingFixture struct {
+	tasks   *TaskService
+	project string
+	ids     map[string]string
+}
+
+func newOrderingFixture(t *testing.T) orderingFixture {
+	t.Helper()
+	ctx := context.Background()
+	store := newTestStore(t)
+	f := orderingFixture{tasks: NewTaskService(store), ids: map[string]string{}}
+
+	area, err := NewAreaService(store).CreateArea(ctx, &pb.CreateAreaRequest{Name: "area"})
+	if err != nil {
+		t.Fatalf("CreateArea: %v", err)
+	}
+	projects := NewProjectService(store)
+	project, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "project", AreaId: area.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	other, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "other", AreaId: area.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	f.project = project.Project.Id
+
+	for _, name := range []string{"trashed", "a", "b", "c", "d", "elsewhere"} {
+		projectID := f.project
+		if name == "elsewhere" {
+			projectID = other.Project.Id
+		}
+		created, err := f.tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: name, ProjectId: projectID})
+		if err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		f.ids[name] = created.Task.Id
+	}
+	if _, err := f.tasks.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: f.ids["trashed"]}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	return f
+}
+
+// order returns the names of the project's tasks in manual order
+func (f orderingFixture) order(t *testing.T) []string {
+	t.Helper()
+	listed, err := f.tasks.ListTasks(context.Background(), &pb.ListTasksRequest{ProjectId: &f.project})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	names := make([]string, len(listed.Tasks))
+	for i, task := range listed.Tasks {
+		names[i] = task.Name
+	}
+	return names
+}
+
+// reorder moves the named task after and/or before the named neighbours
+func (f orderingFixture) reorder(name, after, before string) error {
+	_, err := f.tasks.ReorderTask(context.Background(), &pb.ReorderTaskRequest{
+		Id:       f.ids[name],
+		AfterId:  f.ids[after],
+		BeforeId: f.ids[before],
+	})
+	return err
+}
+
+func TestReorderTask(t *testing.T) {
+	tests := []struct {
+		name          string
+		task          string
+		after, before string
+		want          []string
+		wantCode      codes.Code
+	}{
+		{name: "after", task: "a", after: "d", want: []string{"d", "a", "c", "b"}},
+		{name: "before", task: "d", before: "a", want: []string{"c", "b", "d", "a"}},
+		{name: "between", task: "a", after: "c", before: "b", want: []string{"d", "c", "a", "b"}},
+		{name: "to the start", task: "a", before: "d", want: []string{"a", "d", "c", "b"}},
+		{name: "to the end", task: "d", after: "a", want: []string{"c", "b", "a", "d"}},
+		{name: "neighbours out of order", task: "a", after: "c", before: "d", wantCode: codes.FailedPrecondition},
+		{name: "neighbour in another project", task: "a", after: "elsewhere", wantCode: codes.FailedPrecondition},
+		{name: "trashed neighbour", task: "a", after: "trashed", wantCode: codes.NotFound},
+		{name: "trashed task", task: "trashed", after: "a", wantCode: codes.NotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOrderingFixture(t)
+
+			err := f.reorder(tt.task, tt.after, tt.before)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("ReorderTask = %v, want %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if got := f.order(t); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Splitting the same gap until the keys run out renumbers the project's tasks
+// and leaves trashed tasks and other projects alone
+func TestReorderTaskRenumbersOnlyItsList(t *testing.T) {
+	ctx := context.Background()
+	f := newOrderingFixture(t)
+	queries := f.tasks.store.Queries
+
+	unmoved, err := queries.GetTask(ctx, f.ids["d"])
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	elsewhere, err := queries.GetTask(ctx, f.ids["elsewhere"])
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	trashed, err := queries.GetTrashedTask(ctx, f.ids["trashed"])
+	if err != nil {
+		t.Fatalf("GetTrashedTask: %v", err)
+	}
+
+	// Alternately move a and c directly after d, halving the gap behind d each time
+	for i := 0; i < 100; i++ {
+		name := "a"
+		if i%2 == 1 {
+			name = "c"
+		}
+		if err := f.reorder(name, "d", ""); err != nil {
+			t.Fatalf("ReorderTask %d: %v", i, err)
+		}
+	}
+
+	if got, want := f.order(t), []string{"d", "c", "a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+
+	renumbered, err := queries.GetTask(ctx, f.ids["d"])
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if renumbered.Version == unmoved.Version {
+		t.Errorf("task d was never renumbered")
+	}
+
+	afterElsewhere, err := queries.GetTask(ctx, f.ids["elsewhere"])
+	if err != nil {
+		t.Fatalf("GetTask: %v", err)
+	}
+	if afterElsewhere.SortOrder != elsewhere.SortOrder || afterElsewhere.Version != elsewhere.Version {
+		t.Errorf("task in another project changed from %v (version %d) to %v (version %d)",
+			elsewhere.SortOrder, elsewhere.Version, afterElsewhere.SortOrder, afterElsewhere.Version)
+	}
+
+	afterTrashed, err := queries.GetTrashedTask(ctx, f.ids["trashed"])
+	if err != nil {
+		t.Fatalf("GetTrashedTask: %v", err)
+	}
+	if afterTrashed.SortOrder != trashed.SortOrder || afterTrashed.Version != trashed.Version {
+		t.Errorf("trashed task changed from %v (version %d) to %v (version %d)",
+			trashed.SortOrder, trashed.Version, afterTrashed.SortOrder, afterTrashed.Version)
+	}
+}
+
+// New and moved items take their key from the list they join, so that keys in
+// other lists do not push them apart
+func TestNewItemsKeyedWithinTheirList(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	areas := NewAreaService(store)
+	projects := NewProjectService(store)
+	tasks := NewTaskService(store)
+
+	// Fill one area and project with items whose keys run far from zero
+	busyArea, err := areas.CreateArea(ctx, &pb.CreateAreaRequest{Name: "busy"})
+	if err != nil {
+		t.Fatalf("CreateArea: %v", err)
+	}
+	busy, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "busy", AreaId: busyArea.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "busy", AreaId: busyArea.Area.Id}); err != nil {
+			t.Fatalf("CreateProject: %v", err)
+		}
+		if _, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "busy", ProjectId: busy.Project.Id}); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+		if _, err := projects.CreateHeading(ctx, &pb.CreateHeadingRequest{Name: "busy", ProjectId: busy.Project.Id}); err != nil {
+			t.Fatalf("CreateHeading: %v", err)
+		}
+	}
+
+	emptyArea, err := areas.CreateArea(ctx, &pb.CreateAreaRequest{Name: "empty"})
+	if err != nil {
+		t.Fatalf("CreateArea: %v", err)
+	}
+	project, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "first", AreaId: emptyArea.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	task, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "first", ProjectId: project.Project.Id})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	inbox, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "inbox"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	heading, err := projects.CreateHeading(ctx, &pb.CreateHeadingRequest{Name: "first", ProjectId: project.Project.Id})
+	if err != nil {
+		t.Fatalf("CreateHeading: %v", err)
+	}
+	moved, err := projects.CreateHeading(ctx, &pb.CreateHeadingRequest{Name: "moved", ProjectId: busy.Project.Id})
+	if err != nil {
+		t.Fatalf("CreateHeading: %v", err)
+	}
+	if _, err := projects.MoveHeading(ctx, &pb.MoveHeadingRequest{Id: moved.Heading.Id, ProjectId: project.Project.Id}); err != nil {
+		t.Fatalf("MoveHeading: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		get  func() (float64, error)
+		want float64
+	}{
+		{name: "first project of an area", get: func() (float64, error) {
+			p, err := store.Queries.GetProject(ctx, project.Project.Id)
+			return p.SortOrder, err
+		}, want: 0},
+		{name: "first task of a project", get: func() (float64, error) {
+			got, err := store.Queries.GetTask(ctx, task.Task.Id)
+			return got.SortOrder, err
+		}, want: 0},
+		{name: "first task of the Inbox", get: func() (float64, error) {
+			got, err := store.Queries.GetTask(ctx, inbox.Task.Id)
+			return got.SortOrder, err
+		}, want: 0},
+		{name: "first heading of a project", get: func() (float64, error) {
+			h, err := store.Queries.GetHeading(ctx, heading.Heading.Id)
+			return h.SortOrder, err
+		}, want: 0},
+		{name: "heading moved to the end of a project", get: func() (float64, error) {
+			h, err := store.Queries.GetHeading(ctx, moved.Heading.Id)
+			return h.SortOrder, err
+		}, want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.get()
+			if err != nil {
+				t.Fatalf("failed to get sort order: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sort order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
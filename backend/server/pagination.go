@@ -0,0 +1,141 @@
+package server
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// defaultPageSize is used when a List request does not specify a page size
+	defaultPageSize = 50
+
+	// maxPageSize caps the number of items returned in a single page
+	maxPageSize = 500
+)
+
+// pageCursor identifies the last item of a page in (time, id) keyset order, where
+// time is the column the list is sorted by (created_at unless noted otherwise).
+// Lists that can also be sorted manually record the item's sort_order too, so the
+// same cursor works for either order. Query ties the cursor to the order and
+// filters of the request it was issued for.
+type pageCursor struct {
+	Query     string    `json:"q"`
+	Time      time.Time `json:"c"`
+	ID        string    `json:"i"`
+	SortOrder *float64  `json:"s,omitempty"`
+}
+
+// pageRequest holds the decoded pagination parameters of a List request
+type pageRequest struct {
+	limit  int
+	query  string
+	cursor *pageCursor
+}
+
+// listRequest is a List request that is read one page at a time
+type listRequest interface {
+	proto.Message
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// newPageRequest decodes the page size and token of a List request. A token
+// issued for a different order or different filters is rejected rather than
+// applied to a list it does not belong to.
+func newPageRequest(req listRequest) (pageRequest, error) {
+	limit := int(req.GetPageSize())
+	if limit == 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	page := pageRequest{limit: limit, query: listQuery(req)}
+	pageToken := req.GetPageToken()
+	if pageToken == "" {
+		return page, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return pageRequest{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return pageRequest{}, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	if cursor.Query != page.query {
+		return pageRequest{}, status.Error(codes.InvalidArgument, "page_token was issued for a different order or filters")
+	}
+	page.cursor = &cursor
+
+	return page, nil
+}
+
+// cursorTime returns the sort time of the cursor, or NULL for the first page
+func (p pageRequest) cursorTime() sql.NullTime {
+	if p.cursor == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: p.cursor.Time, Valid: true}
+}
+
+// cursorSortOrder returns the sort_order of the cursor, or NULL for the first page
+func (p pageRequest) cursorSortOrder() sql.NullFloat64 {
+	if p.cursor == nil || p.cursor.SortOrder == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *p.cursor.SortOrder, Valid: true}
+}
+
+// cursorID returns the id of the cursor, or NULL for the first page
+func (p pageRequest) cursorID() sql.NullString {
+	if p.cursor == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: p.cursor.ID, Valid: true}
+}
+
+// fetchLimit returns the number of rows to query; one extra row tells us whether another page exists
+func (p pageRequest) fetchLimit() int64 {
+	return int64(p.limit) + 1
+}
+
+// paginate trims rows fetched with fetchLimit to the page size and returns the
+// token for the next page, or an empty token if this is the last page
+func paginate[T any](p pageRequest, rows []T, cursor func(T) pageCursor) ([]T, string) {
+	if len(rows) <= p.limit {
+		return rows, ""
+	}
+
+	rows = rows[:p.limit]
+	next := cursor(rows[len(rows)-1])
+	next.Query = p.query
+	data, _ := json.Marshal(next)
+	return rows, base64.RawURLEncoding.EncodeToString(data)
+}
+
+// listQuery hashes everything in a List request except its paging fields, so
+// that a page token can be checked against the request it is used with
+func listQuery(req listRequest) string {
+	msg := proto.Clone(req).ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"page_size", "page_token"} {
+		if field := fields.ByName(name); field != nil {
+			msg.Clear(field)
+		}
+	}
+
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
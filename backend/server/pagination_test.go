@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+func TestNewPageRequest(t *testing.T) {
+	sortOrder := 1.5
+	created := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	first, err := newPageRequest(&pb.ListTasksRequest{Inbox: true, Order: pb.ListOrder_LIST_ORDER_CREATED, PageSize: 1})
+	if err != nil {
+		t.Fatalf("newPageRequest: %v", err)
+	}
+	_, token := paginate(first, []int{1, 2}, func(int) pageCursor {
+		return pageCursor{Time: created, ID: "id", SortOrder: &sortOrder}
+	})
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name       string
+		req        *pb.ListTasksRequest
+		wantLimit  int
+		wantCursor *pageCursor
+		wantCode   codes.Code
+	}{
+		{name: "default page size", req: &pb.ListTasksRequest{}, wantLimit: defaultPageSize},
+		{name: "page size", req: &pb.ListTasksRequest{PageSize: 10}, wantLimit: 10},
+		{name: "page size capped", req: &pb.ListTasksRequest{PageSize: maxPageSize + 1}, wantLimit: maxPageSize},
+		{
+			name:       "token from paginate",
+			req:        &pb.ListTasksRequest{Inbox: true, Order: pb.ListOrder_LIST_ORDER_CREATED, PageToken: token},
+			wantLimit:  defaultPageSize,
+			wantCursor: &pageCursor{Query: first.query, Time: created, ID: "id", SortOrder: &sortOrder},
+		},
+		{
+			name:       "token with a different page size",
+			req:        &pb.ListTasksRequest{Inbox: true, Order: pb.ListOrder_LIST_ORDER_CREATED, PageToken: token, PageSize: 5},
+			wantLimit:  5,
+			wantCursor: &pageCursor{Query: first.query, Time: created, ID: "id", SortOrder: &sortOrder},
+		},
+		{
+			name:     "token for a different order",
+			req:      &pb.ListTasksRequest{Inbox: true, Order: pb.ListOrder_LIST_ORDER_MANUAL, PageToken: token},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "token for different filters",
+			req:      &pb.ListTasksRequest{Order: pb.ListOrder_LIST_ORDER_CREATED, PageToken: token},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "token for another list",
+			req:      &pb.ListTasksRequest{PageToken: encode(`{"q":"other","c":"2024-03-10T12:00:00Z","i":"id"}`)},
+			wantCode: codes.InvalidArgument,
+		},
+		{name: "token not base64", req: &pb.ListTasksRequest{PageToken: "not base64!"}, wantCode: codes.InvalidArgument},
+		{name: "token not json", req: &pb.ListTasksRequest{PageToken: encode("not json")}, wantCode: codes.InvalidArgument},
+		{name: "token without id", req: &pb.ListTasksRequest{PageToken: encode(`{"c":"2024-03-10T12:00:00Z"}`)}, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := newPageRequest(tt.req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("newPageRequest = %v, want %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if page.limit != tt.wantLimit {
+				t.Errorf("limit = %d, want %d", page.limit, tt.wantLimit)
+			}
+			if !reflect.DeepEqual(page.cursor, tt.wantCursor) {
+				t.Errorf("cursor = %+v, want %+v", page.cursor, tt.wantCursor)
+			}
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	cursor := func(row int) pageCursor {
+		return pageCursor{ID: string(rune('a' + row))}
+	}
+
+	tests := []struct {
+		name       string
+		rows       []int
+		wantRows   []int
+		wantCursor string
+	}{
+		{name: "empty", rows: []int{}, wantRows: []int{}},
+		{name: "short page", rows: []int{0, 1}, wantRows: []int{0, 1}},
+		{name: "exactly one page", rows: []int{0, 1, 2}, wantRows: []int{0, 1, 2}},
+		{name: "more pages", rows: []int{0, 1, 2, 3}, wantRows: []int{0, 1, 2}, wantCursor: "c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := newPageRequest(&pb.ListTagsRequest{PageSize: 3})
+			if err != nil {
+				t.Fatalf("newPageRequest: %v", err)
+			}
+			rows, token := paginate(p, tt.rows, cursor)
+			if !reflect.DeepEqual(rows, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", rows, tt.wantRows)
+			}
+			if tt.wantCursor == "" {
+				if token != "" {
+					t.Errorf("token = %q, want none", token)
+				}
+				return
+			}
+
+			next, err := newPageRequest(&pb.ListTagsRequest{PageSize: 3, PageToken: token})
+			if err != nil {
+				t.Fatalf("newPageRequest: %v", err)
+			}
+			if next.cursorID().String != tt.wantCursor {
+				t.Errorf("cursor id = %q, want %q", next.cursorID().String, tt.wantCursor)
+			}
+		})
+	}
+}
+
+// Paging through tasks in either order returns each task once, even when
+// the last task of a page is deleted before the next page is read
+func TestListTasksPages(t *testing.T) {
+	ctx := context.Background()
+	tasks := NewTaskService(newTestStore(t))
+
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		if _, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: name}); err != nil {
+			t.Fatalf("CreateTask: %v", err)
+		}
+	}
+
+	for _, order := range []pb.ListOrder{pb.ListOrder_LIST_ORDER_MANUAL, pb.ListOrder_LIST_ORDER_CREATED} {
+		t.Run(order.String(), func(t *testing.T) {
+			all, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{Order: order})
+			if err != nil {
+				t.Fatalf("ListTasks: %v", err)
+			}
+			var want []string
+			for _, task := range all.Tasks {
+				want = append(want, task.Name)
+			}
+
+			var got []string
+			req := &pb.ListTasksRequest{Order: order, PageSize: 3}
+			for {
+				page, err := tasks.ListTasks(ctx, req)
+				if err != nil {
+					t.Fatalf("ListTasks: %v", err)
+				}
+				for _, task := range page.Tasks {
+					got = append(got, task.Name)
+				}
+				if page.NextPageToken == "" {
+					break
+				}
+				req.PageToken = page.NextPageToken
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("paged tasks = %v, want %v", got, want)
+			}
+		})
+	}
+
+	t.Run("cursor task deleted", func(t *testing.T) {
+		first, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 3})
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		last := first.Tasks[len(first.Tasks)-1]
+		if _, err := tasks.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: last.Id}); err != nil {
+			t.Fatalf("DeleteTask: %v", err)
+		}
+
+		second, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{PageSize: 3, PageToken: first.NextPageToken})
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		all, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{})
+		if err != nil {
+			t.Fatalf("ListTasks: %v", err)
+		}
+		for i, task := range second.Tasks {
+			if want := all.Tasks[len(first.Tasks)-1+i]; task.Id != want.Id {
+				t.Errorf("second page task %d = %s, want %s", i, task.Name, want.Name)
+			}
+		}
+	})
+}
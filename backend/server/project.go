@@ -29,15 +29,25 @@ func NewProjectService(store *db.Store) *ProjectService {
 
 // CreateProject creates a new project
 func (s *ProjectService) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
-	if req.AreaId == "" {
-		return nil, status.Error(codes.InvalidArgument, "area_id is required")
+	var pbProject *pb.Project
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		var err error
+		pbProject, err = createProject(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	return &pb.CreateProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// createProject creates a project and sets its tags within q's transaction
+func createProject(ctx context.Context, q db.Querier, req *pb.CreateProjectRequest) (*pb.Project, error) {
 	// Validate that the area exists
-	areaExists, err := s.store.Queries.AreaExists(ctx, req.AreaId)
+	areaExists, err := q.AreaExists(ctx, req.AreaId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check area existence: %v", err)
 	}
@@ -48,11 +58,12 @@ func (s *ProjectService) CreateProject(ctx context.Context, req *pb.CreateProjec
 	now := time.Now()
 	id := uuid.New().String()
 
-	project, err := s.store.Queries.CreateProject(ctx, db.CreateProjectParams{
+	project, err := q.CreateProject(ctx, db.CreateProjectParams{
 		ID:        id,
 		Name:      req.Name,
 		AreaID:    req.AreaId,
 		Notes:     req.Notes,
+		Deadline:  nullTimeFromProto(req.Deadline),
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
@@ -60,17 +71,20 @@ func (s *ProjectService) CreateProject(ctx context.Context, req *pb.CreateProjec
 		return nil, status.Errorf(codes.Internal, "failed to create project: %v", err)
 	}
 
-	return &pb.CreateProjectResponse{
-		Project: dbProjectToProto(project),
-	}, nil
+	if err := setProjectTags(ctx, q, project.ID, req.TagIds); err != nil {
+		return nil, err
+	}
+
+	pbProject := dbProjectToProto(project)
+	pbProject.TagIds = req.TagIds
+	if err := withProjectProgress(ctx, q, now, pbProject); err != nil {
+		return nil, err
+	}
+	return pbProject, nil
 }
 
 // GetProject retrieves a project by ID
 func (s *ProjectService) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
-
 	project, err := s.store.Queries.GetProject(ctx, req.Id)
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "project not found: %s", req.Id)
@@ -79,41 +93,90 @@ func (s *ProjectService) GetProject(ctx context.Context, req *pb.GetProjectReque
 		return nil, status.Errorf(codes.Internal, "failed to get project: %v", err)
 	}
 
+	pbProject := dbProjectToProto(project)
+	if err := withProjectDetails(ctx, s.store.Queries, pbProject); err != nil {
+		return nil, err
+	}
+
 	return &pb.GetProjectResponse{
-		Project: dbProjectToProto(project),
+		Project: pbProject,
 	}, nil
 }
 
-// ListProjects lists projects, optionally filtered by area
+// ListProjects lists projects one page at a time, optionally filtered by area and tags
 func (s *ProjectService) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	page, err := newPageRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var areaID sql.NullString
 	if req.AreaId != nil && *req.AreaId != "" {
 		areaID = sql.NullString{String: *req.AreaId, Valid: true}
 	}
 
-	projects, err := s.store.Queries.ListProjects(ctx, areaID)
+	var projectStatus sql.NullString
+	if req.Status != nil && *req.Status != pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		projectStatus = sql.NullString{String: projectStatusToDB(*req.Status), Valid: true}
+	}
+
+	tagIDs, tagMinMatches := tagFilter(req.TagIds, req.TagMatch)
+
+	projects, err := s.store.Queries.ListProjects(ctx, db.ListProjectsParams{
+		AreaID:          areaID,
+		Status:          projectStatus,
+		TagIds:          tagIDs,
+		TagMinMatches:   tagMinMatches,
+		ManualOrder:     manualOrder(req.Order),
+		IncludeArchived: req.IncludeArchived,
+		CursorSortOrder: page.cursorSortOrder(),
+		CursorCreatedAt: page.cursorTime(),
+		CursorID:        page.cursorID(),
+		Limit:           page.fetchLimit(),
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list projects: %v", err)
 	}
 
+	projects, nextPageToken := paginate(page, projects, func(project db.Project) pageCursor {
+		return pageCursor{Time: project.CreatedAt, ID: project.ID, SortOrder: &project.SortOrder}
+	})
+
 	pbProjects := make([]*pb.Project, len(projects))
 	for i, project := range projects {
 		pbProjects[i] = dbProjectToProto(project)
 	}
+	if err := withProjectDetails(ctx, s.store.Queries, pbProjects...); err != nil {
+		return nil, err
+	}
 
 	return &pb.ListProjectsResponse{
-		Projects: pbProjects,
+		Projects:      pbProjects,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // UpdateProject updates an existing project
 func (s *ProjectService) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	var pbProject *pb.Project
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		var err error
+		pbProject, err = updateProject(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	return &pb.UpdateProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// updateProject applies an update to a project within q's transaction
+func updateProject(ctx context.Context, q db.Querier, req *pb.UpdateProjectRequest) (*pb.Project, error) {
 	// Check if project exists
-	exists, err := s.store.Queries.ProjectExists(ctx, req.Id)
+	exists, err := q.ProjectExists(ctx, req.Id)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to check project existence: %v", err)
 	}
@@ -132,53 +195,218 @@ func (s *ProjectService) UpdateProject(ctx context.Context, req *pb.UpdateProjec
 		notes = sql.NullString{String: *req.Notes, Valid: true}
 	}
 
-	project, err := s.store.Queries.UpdateProject(ctx, db.UpdateProjectParams{
-		ID:        req.Id,
-		Name:      name,
-		Notes:     notes,
-		UpdatedAt: time.Now(),
+	var areaID sql.NullString
+	if req.AreaId != nil {
+		// Validate that the destination area exists
+		areaExists, err := q.AreaExists(ctx, *req.AreaId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+		}
+		if !areaExists {
+			return nil, status.Errorf(codes.NotFound, "area not found: %s", *req.AreaId)
+		}
+		areaID = sql.NullString{String: *req.AreaId, Valid: true}
+	}
+
+	project, err := q.UpdateProject(ctx, db.UpdateProjectParams{
+		ID:              req.Id,
+		Name:            name,
+		Notes:           notes,
+		AreaID:          areaID,
+		Deadline:        nullTimeFromProto(req.Deadline),
+		ClearDeadline:   req.ClearDeadline,
+		UpdatedAt:       time.Now(),
+		ExpectedVersion: nullVersion(req.ExpectedVersion),
 	})
+	if err == sql.ErrNoRows {
+		return nil, conditionalUpdateError("project", req.Id, req.ExpectedVersion)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update project: %v", err)
 	}
 
-	return &pb.UpdateProjectResponse{
-		Project: dbProjectToProto(project),
-	}, nil
+	if req.SetTags {
+		if err := setProjectTags(ctx, q, project.ID, req.TagIds); err != nil {
+			return nil, err
+		}
+	}
+
+	pbProject := dbProjectToProto(project)
+	if err := withProjectDetails(ctx, q, pbProject); err != nil {
+		return nil, err
+	}
+	return pbProject, nil
 }
 
-// DeleteProject deletes a project
+// DeleteProject moves a project to the trash. Depending on the mode, a project
+// that still has tasks is refused, trashed along with its tasks, or has its
+// tasks moved to another project (or the Inbox) first.
 func (s *ProjectService) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		return deleteProject(ctx, q, req)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if project exists
-	exists, err := s.store.Queries.ProjectExists(ctx, req.Id)
+	return &pb.DeleteProjectResponse{
+		Success: true,
+	}, nil
+}
+
+// deleteProject moves a project to the trash within q's transaction, handling
+// its tasks according to the request's mode
+func deleteProject(ctx context.Context, q db.Querier, req *pb.DeleteProjectRequest) error {
+	projectID := sql.NullString{String: req.Id, Valid: true}
+	deletedAt := sql.NullTime{Time: time.Now(), Valid: true}
+
+	project, err := q.GetProject(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "project not found: %s", req.Id)
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		return status.Errorf(codes.Internal, "failed to get project: %v", err)
 	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "project not found: %s", req.Id)
+	if err := checkVersion("project", req.Id, req.ExpectedVersion, project.Version); err != nil {
+		return err
 	}
 
-	if err := s.store.Queries.DeleteProject(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete project: %v", err)
+	switch req.Mode {
+	case pb.DeleteMode_DELETE_MODE_CASCADE:
+		// Tasks share the project's deleted_at so they are restored with it
+		if err := q.TrashTasksInProject(ctx, db.TrashTasksInProjectParams{
+			ProjectID: projectID,
+			DeletedAt: deletedAt,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete project tasks: %v", err)
+		}
+
+	case pb.DeleteMode_DELETE_MODE_REASSIGN:
+		// Validate that the target project exists; no target means the Inbox
+		if req.ReassignProjectId != "" {
+			targetExists, err := q.ProjectExists(ctx, req.ReassignProjectId)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+			}
+			if !targetExists {
+				return status.Errorf(codes.NotFound, "project not found: %s", req.ReassignProjectId)
+			}
+		}
+		if err := q.ReassignTasks(ctx, db.ReassignTasksParams{
+			OldProjectID: projectID,
+			NewProjectID: sql.NullString{String: req.ReassignProjectId, Valid: req.ReassignProjectId != ""},
+			UpdatedAt:    time.Now(),
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to reassign project tasks: %v", err)
+		}
+
+	default:
+		taskCount, err := q.CountTasksInProject(ctx, projectID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to count project tasks: %v", err)
+		}
+		if err := restrictedDeleteError("project", req.Id,
+			childCount{kind: "tasks", count: taskCount},
+		); err != nil {
+			return err
+		}
 	}
 
-	return &pb.DeleteProjectResponse{
-		Success: true,
+	if err := q.TrashProject(ctx, db.TrashProjectParams{
+		ID:        req.Id,
+		DeletedAt: deletedAt,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete project: %v", err)
+	}
+	return nil
+}
+
+// ReorderProject moves a project directly after after_id and/or before before_id in the manual order
+func (s *ProjectService) ReorderProject(ctx context.Context, req *pb.ReorderProjectRequest) (*pb.ReorderProjectResponse, error) {
+	var pbProject *pb.Project
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if err := projectSortOrders(q).reorder(ctx, req.Id, req.AfterId, req.BeforeId); err != nil {
+			return err
+		}
+
+		project, err := q.GetProject(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get project: %v", err)
+		}
+		pbProject = dbProjectToProto(project)
+		return withProjectDetails(ctx, q, pbProject)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderProjectResponse{
+		Project: pbProject,
 	}, nil
 }
 
+// withProjectDetails fills in the tags and progress of the given projects
+func withProjectDetails(ctx context.Context, q db.Querier, projects ...*pb.Project) error {
+	if err := withProjectTags(ctx, q, projects...); err != nil {
+		return err
+	}
+	return withProjectProgress(ctx, q, time.Now(), projects...)
+}
+
+// withProjectProgress fills in the task counts of the given projects with a
+// single query. Tasks due before now count as overdue.
+func withProjectProgress(ctx context.Context, q db.Querier, now time.Time, projects ...*pb.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Project, len(projects))
+	ids := make([]string, len(projects))
+	for i, project := range projects {
+		project.Progress = &pb.ProjectProgress{}
+		byID[project.Id] = project
+		ids[i] = project.Id
+	}
+
+	rows, err := q.ListProjectProgress(ctx, db.ListProjectProgressParams{
+		Now:        sql.NullTime{Time: now, Valid: true},
+		ProjectIds: ids,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get project progress: %v", err)
+	}
+	for _, row := range rows {
+		progress := byID[row.ProjectID.String].Progress
+		progress.OpenTasks = int32(row.OpenTasks)
+		progress.CompletedTasks = int32(row.CompletedTasks)
+		progress.OverdueTasks = int32(row.OverdueTasks)
+		if total := row.OpenTasks + row.CompletedTasks; total > 0 {
+			progress.PercentComplete = int32(row.CompletedTasks * 100 / total)
+		}
+	}
+	return nil
+}
+
 // dbProjectToProto converts a database project to a protobuf project
 func dbProjectToProto(project db.Project) *pb.Project {
-	return &pb.Project{
+	pbProject := &pb.Project{
 		Id:        project.ID,
 		Name:      project.Name,
 		AreaId:    project.AreaID,
 		Notes:     project.Notes,
+		Status:    projectStatusFromDB(project.Status),
 		CreatedAt: timestamppb.New(project.CreatedAt),
 		UpdatedAt: timestamppb.New(project.UpdatedAt),
+		Version:   project.Version,
+	}
+	if project.CompletedAt.Valid {
+		pbProject.CompletedAt = timestamppb.New(project.CompletedAt.Time)
+	}
+	if project.ArchivedAt.Valid {
+		pbProject.ArchivedAt = timestamppb.New(project.ArchivedAt.Time)
+	}
+	if project.Deadline.Valid {
+		pbProject.Deadline = timestamppb.New(project.Deadline.Time)
 	}
+	return pbProject
 }
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Project status values as stored in the database
+const (
+	projectStatusActive    = "active"
+	projectStatusSomeday   = "someday"
+	projectStatusCompleted = "completed"
+	projectStatusArchived  = "archived"
+)
+
+// projectTransitions lists the statuses a project may move to from each status
+var projectTransitions = map[string][]string{
+	projectStatusActive:    {projectStatusSomeday, projectStatusCompleted, projectStatusArchived},
+	projectStatusSomeday:   {projectStatusActive, projectStatusCompleted, projectStatusArchived},
+	projectStatusCompleted: {projectStatusActive, projectStatusArchived},
+	projectStatusArchived:  {projectStatusActive},
+}
+
+// CompleteProject marks a project as completed. Its open tasks are left alone
+// unless the request asks for them to be completed or cancelled along with it;
+// tasks finished this way do not schedule the next occurrence of a series.
+func (s *ProjectService) CompleteProject(ctx context.Context, req *pb.CompleteProjectRequest) (*pb.CompleteProjectResponse, error) {
+	var pbProject *pb.Project
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		now := time.Now()
+		project, err := setProjectStatus(ctx, q, req.Id, projectStatusCompleted, now)
+		if err != nil {
+			return err
+		}
+
+		if req.OpenTasks == pb.OpenTaskAction_OPEN_TASK_ACTION_COMPLETE || req.OpenTasks == pb.OpenTaskAction_OPEN_TASK_ACTION_CANCEL {
+			taskStatus := taskStatusCompleted
+			if req.OpenTasks == pb.OpenTaskAction_OPEN_TASK_ACTION_CANCEL {
+				taskStatus = taskStatusCancelled
+			}
+			if err := q.FinishProjectTasks(ctx, db.FinishProjectTasksParams{
+				ProjectID:   sql.NullString{String: req.Id, Valid: true},
+				Status:      taskStatus,
+				CompletedAt: sql.NullTime{Time: now, Valid: true},
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to finish project tasks: %v", err)
+			}
+		}
+
+		pbProject = dbProjectToProto(project)
+		return withProjectDetails(ctx, q, pbProject)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// DeferProject parks an active project for some day
+func (s *ProjectService) DeferProject(ctx context.Context, req *pb.DeferProjectRequest) (*pb.DeferProjectResponse, error) {
+	pbProject, err := s.transitionProject(ctx, req.Id, projectStatusSomeday)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeferProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// ArchiveProject puts a project away for reference
+func (s *ProjectService) ArchiveProject(ctx context.Context, req *pb.ArchiveProjectRequest) (*pb.ArchiveProjectResponse, error) {
+	pbProject, err := s.transitionProject(ctx, req.Id, projectStatusArchived)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ArchiveProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// ActivateProject makes a someday, completed or archived project active again
+func (s *ProjectService) ActivateProject(ctx context.Context, req *pb.ActivateProjectRequest) (*pb.ActivateProjectResponse, error) {
+	pbProject, err := s.transitionProject(ctx, req.Id, projectStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ActivateProjectResponse{
+		Project: pbProject,
+	}, nil
+}
+
+// transitionProject moves a project to a new status in its own transaction
+func (s *ProjectService) transitionProject(ctx context.Context, id, newStatus string) (*pb.Project, error) {
+	var pbProject *pb.Project
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		project, err := setProjectStatus(ctx, q, id, newStatus, time.Now())
+		if err != nil {
+			return err
+		}
+		pbProject = dbProjectToProto(project)
+		return withProjectDetails(ctx, q, pbProject)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pbProject, nil
+}
+
+// setProjectStatus moves a project to a new status, returning FailedPrecondition
+// if its current status does not allow it. Completing stamps completed_at,
+// archiving stamps archived_at and keeps completed_at, and any other move
+// clears both.
+func setProjectStatus(ctx context.Context, q db.Querier, id, newStatus string, now time.Time) (db.Project, error) {
+	project, err := q.GetProject(ctx, id)
+	if err == sql.ErrNoRows {
+		return db.Project{}, status.Errorf(codes.NotFound, "project not found: %s", id)
+	}
+	if err != nil {
+		return db.Project{}, status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+	if !canTransitionProject(project.Status, newStatus) {
+		return db.Project{}, status.Errorf(codes.FailedPrecondition, "project %s is %s and cannot become %s", id, project.Status, newStatus)
+	}
+
+	var completedAt, archivedAt sql.NullTime
+	switch newStatus {
+	case projectStatusCompleted:
+		completedAt = sql.NullTime{Time: now, Valid: true}
+	case projectStatusArchived:
+		completedAt = project.CompletedAt
+		archivedAt = sql.NullTime{Time: now, Valid: true}
+	}
+
+	project, err = q.SetProjectStatus(ctx, db.SetProjectStatusParams{
+		ID:          id,
+		Status:      newStatus,
+		CompletedAt: completedAt,
+		ArchivedAt:  archivedAt,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		return db.Project{}, status.Errorf(codes.Internal, "failed to update project status: %v", err)
+	}
+	return project, nil
+}
+
+// canTransitionProject reports whether a project may move between two statuses
+func canTransitionProject(from, to string) bool {
+	for _, allowed := range projectTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// projectStatusToDB converts a protobuf project status to its database representation
+func projectStatusToDB(s pb.ProjectStatus) string {
+	switch s {
+	case pb.ProjectStatus_PROJECT_STATUS_SOMEDAY:
+		return projectStatusSomeday
+	case pb.ProjectStatus_PROJECT_STATUS_COMPLETED:
+		return projectStatusCompleted
+	case pb.ProjectStatus_PROJECT_STATUS_ARCHIVED:
+		return projectStatusArchived
+	default:
+		return projectStatusActive
+	}
+}
+
+// projectStatusFromDB converts a database project status to its protobuf representation
+func projectStatusFromDB(s string) pb.ProjectStatus {
+	switch s {
+	case projectStatusActive:
+		return pb.ProjectStatus_PROJECT_STATUS_ACTIVE
+	case projectStatusSomeday:
+		return pb.ProjectStatus_PROJECT_STATUS_SOMEDAY
+	case projectStatusCompleted:
+		return pb.ProjectStatus_PROJECT_STATUS_COMPLETED
+	case projectStatusArchived:
+		return pb.ProjectStatus_PROJECT_STATUS_ARCHIVED
+	default:
+		return pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+	}
+}
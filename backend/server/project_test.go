@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Deleting a project into another moves only its live tasks; trashed ones
+// stay with it in the trash
+func TestDeleteProjectReassignLeavesTrashedTasks(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	projects := NewProjectService(store)
+	tasks := NewTaskService(store)
+
+	area, err := NewAreaService(store).CreateArea(ctx, &pb.CreateAreaRequest{Name: "area"})
+	if err != nil {
+		t.Fatalf("CreateArea: %v", err)
+	}
+	deleted, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "deleted", AreaId: area.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	target, err := projects.CreateProject(ctx, &pb.CreateProjectRequest{Name: "target", AreaId: area.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	live, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "live", ProjectId: deleted.Project.Id})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	trashed, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "trashed", ProjectId: deleted.Project.Id})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if _, err := tasks.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: trashed.Task.Id}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+	before, err := store.Queries.GetTrashedTask(ctx, trashed.Task.Id)
+	if err != nil {
+		t.Fatalf("GetTrashedTask: %v", err)
+	}
+
+	if _, err := projects.DeleteProject(ctx, &pb.DeleteProjectRequest{
+		Id:                deleted.Project.Id,
+		Mode:              pb.DeleteMode_DELETE_MODE_REASSIGN,
+		ReassignProjectId: target.Project.Id,
+	}); err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+
+	listed, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{ProjectId: &target.Project.Id})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(listed.Tasks) != 1 || listed.Tasks[0].Id != live.Task.Id {
+		t.Errorf("target project tasks = %v, want only the live task", listed.Tasks)
+	}
+
+	after, err := store.Queries.GetTrashedTask(ctx, trashed.Task.Id)
+	if err != nil {
+		t.Fatalf("GetTrashedTask: %v", err)
+	}
+	if after.ProjectID != before.ProjectID || after.Version != before.Version {
+		t.Errorf("trashed task moved to %v (version %d), want it left in %v (version %d)",
+			after.ProjectID.String, after.Version, before.ProjectID.String, before.Version)
+	}
+}
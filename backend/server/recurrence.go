@@ -0,0 +1,434 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Recurrence frequency values stored in the task_series table
+const (
+	recurrenceDaily   = "daily"
+	recurrenceWeekly  = "weekly"
+	recurrenceMonthly = "monthly"
+	recurrenceYearly  = "yearly"
+)
+
+// Recurrence mode values stored in the task_series table
+const (
+	recurrenceModeFixed           = "fixed"
+	recurrenceModeAfterCompletion = "after_completion"
+)
+
+// maxCatchUpOccurrences bounds how many missed occurrences a fixed schedule skips
+// over when an occurrence is completed late
+const maxCatchUpOccurrences = 10000
+
+// SetTaskRecurrence makes an open task repeat, or changes the rule of its
+// series so that every future occurrence follows the new rule
+func (s *TaskService) SetTaskRecurrence(ctx context.Context, req *pb.SetTaskRecurrenceRequest) (*pb.SetTaskRecurrenceResponse, error) {
+	rule := recurrenceFromProto(req.Rule)
+
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		task, err := getOpenTask(ctx, q, req.TaskId)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if task.SeriesID.Valid {
+			if _, err := q.UpdateTaskSeries(ctx, db.UpdateTaskSeriesParams{
+				ID:            task.SeriesID.String,
+				Frequency:     rule.frequency,
+				IntervalCount: int64(rule.interval),
+				Weekdays:      int64(rule.weekdays),
+				Mode:          rule.mode,
+				UpdatedAt:     now,
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to update task series: %v", err)
+			}
+		} else {
+			series, err := q.CreateTaskSeries(ctx, db.CreateTaskSeriesParams{
+				ID:            uuid.New().String(),
+				Frequency:     rule.frequency,
+				IntervalCount: int64(rule.interval),
+				Weekdays:      int64(rule.weekdays),
+				Mode:          rule.mode,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to create task series: %v", err)
+			}
+			task, err = q.SetTaskSeries(ctx, db.SetTaskSeriesParams{
+				ID:        task.ID,
+				SeriesID:  sql.NullString{String: series.ID, Valid: true},
+				UpdatedAt: now,
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to attach task series: %v", err)
+			}
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetTaskRecurrenceResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// SkipTaskOccurrence moves the current occurrence of a recurring task to the
+// dates of the occurrence after it
+func (s *TaskService) SkipTaskOccurrence(ctx context.Context, req *pb.SkipTaskOccurrenceRequest) (*pb.SkipTaskOccurrenceResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		task, err := getOpenTask(ctx, q, req.TaskId)
+		if err != nil {
+			return err
+		}
+		if !task.SeriesID.Valid {
+			return status.Errorf(codes.FailedPrecondition, "task %s does not repeat", req.TaskId)
+		}
+
+		series, err := q.GetTaskSeries(ctx, task.SeriesID.String)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get task series: %v", err)
+		}
+
+		now := time.Now()
+		dueDate, startDate := recurrenceFromDB(series).nextDates(task, now)
+		task, err = q.SetTaskDates(ctx, db.SetTaskDatesParams{
+			ID:        task.ID,
+			DueDate:   dueDate,
+			StartDate: startDate,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to reschedule task: %v", err)
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SkipTaskOccurrenceResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// StopTaskRecurrence detaches an open task from its series so that completing
+// it no longer creates another occurrence. Past occurrences keep their series.
+func (s *TaskService) StopTaskRecurrence(ctx context.Context, req *pb.StopTaskRecurrenceRequest) (*pb.StopTaskRecurrenceResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		task, err := getOpenTask(ctx, q, req.TaskId)
+		if err != nil {
+			return err
+		}
+
+		if task.SeriesID.Valid {
+			task, err = q.SetTaskSeries(ctx, db.SetTaskSeriesParams{
+				ID:        task.ID,
+				UpdatedAt: time.Now(),
+			})
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to detach task series: %v", err)
+			}
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StopTaskRecurrenceResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// getOpenTask retrieves a task, failing unless it exists and is still open
+func getOpenTask(ctx context.Context, q db.Querier, id string) (db.Task, error) {
+	task, err := q.GetTask(ctx, id)
+	if err == sql.ErrNoRows {
+		return db.Task{}, status.Errorf(codes.NotFound, "task not found: %s", id)
+	}
+	if err != nil {
+		return db.Task{}, status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
+	if task.Status != taskStatusOpen {
+		return db.Task{}, status.Errorf(codes.FailedPrecondition, "task %s is %s; only open tasks can be scheduled", id, task.Status)
+	}
+	return task, nil
+}
+
+// createNextOccurrence creates the occurrence that follows a recurring task
+// completed at completedAt. The new task copies the name, notes, project,
+// heading, tags and (unticked) checklist of the previous one.
+func createNextOccurrence(ctx context.Context, q db.Querier, task db.Task, completedAt time.Time) (*pb.Task, error) {
+	series, err := q.GetTaskSeries(ctx, task.SeriesID.String)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task series: %v", err)
+	}
+
+	now := time.Now()
+	dueDate, startDate := recurrenceFromDB(series).nextDates(task, completedAt)
+	next, err := q.CreateTask(ctx, db.CreateTaskParams{
+		ID:        uuid.New().String(),
+		Name:      task.Name,
+		Notes:     task.Notes,
+		ProjectID: task.ProjectID,
+		DueDate:   dueDate,
+		StartDate: startDate,
+		SeriesID:  task.SeriesID,
+		HeadingID: task.HeadingID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create next occurrence: %v", err)
+	}
+
+	if err := q.CopyTaskTags(ctx, db.CopyTaskTagsParams{
+		FromTaskID: task.ID,
+		ToTaskID:   next.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to copy task tags: %v", err)
+	}
+
+	items, err := q.ListChecklistItems(ctx, []string{task.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list checklist items: %v", err)
+	}
+	for _, item := range items {
+		if _, err := q.CreateChecklistItem(ctx, db.CreateChecklistItemParams{
+			ID:        uuid.New().String(),
+			TaskID:    next.ID,
+			Name:      item.Name,
+			Position:  item.Position,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to copy checklist item: %v", err)
+		}
+	}
+
+	return dbTaskToProto(next), nil
+}
+
+// withTaskRecurrence fills in the repeat rules of the given tasks with a single query
+func withTaskRecurrence(ctx context.Context, q db.Querier, tasks ...*pb.Task) error {
+	var ids []string
+	for _, task := range tasks {
+		if task.SeriesId != "" {
+			ids = append(ids, task.SeriesId)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	series, err := q.ListTaskSeries(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list task series: %v", err)
+	}
+
+	rules := make(map[string]*pb.RecurrenceRule, len(series))
+	for _, ser := range series {
+		rules[ser.ID] = recurrenceFromDB(ser).toProto()
+	}
+	for _, task := range tasks {
+		task.Recurrence = rules[task.SeriesId]
+	}
+	return nil
+}
+
+// recurrence is a repeat rule in the form used to schedule occurrences
+type recurrence struct {
+	frequency string
+	interval  int
+	// weekdays is a bitmask indexed by time.Weekday; zero repeats on the anchor's weekday
+	weekdays uint8
+	mode     string
+}
+
+// nextDates returns the due and start dates of the occurrence after task,
+// which was completed (or skipped) at completedAt. The gap between the start
+// and due dates of the task is preserved.
+func (r recurrence) nextDates(task db.Task, completedAt time.Time) (sql.NullTime, sql.NullTime) {
+	today := startOfDay(completedAt)
+
+	var anchor time.Time
+	switch {
+	case r.mode == recurrenceModeAfterCompletion:
+		anchor = today
+	case task.DueDate.Valid:
+		anchor = task.DueDate.Time
+	case task.StartDate.Valid:
+		anchor = task.StartDate.Time
+	default:
+		anchor = today
+	}
+
+	next := r.next(anchor)
+	// A fixed schedule skips occurrences that were missed while the task was overdue
+	for i := 0; i < maxCatchUpOccurrences && next.Before(today); i++ {
+		next = r.next(next)
+	}
+
+	switch {
+	case task.DueDate.Valid && task.StartDate.Valid:
+		lead := daysBetween(task.StartDate.Time, task.DueDate.Time)
+		return sql.NullTime{Time: next, Valid: true}, sql.NullTime{Time: next.AddDate(0, 0, -lead), Valid: true}
+	case task.StartDate.Valid:
+		return sql.NullTime{}, sql.NullTime{Time: next, Valid: true}
+	default:
+		return sql.NullTime{Time: next, Valid: true}, sql.NullTime{}
+	}
+}
+
+// next returns the first occurrence strictly after anchor
+func (r recurrence) next(anchor time.Time) time.Time {
+	switch r.frequency {
+	case recurrenceDaily:
+		return anchor.AddDate(0, 0, r.interval)
+
+	case recurrenceWeekly:
+		if r.weekdays == 0 {
+			return anchor.AddDate(0, 0, 7*r.interval)
+		}
+		// Walk forward day by day through the anchor's week and the weeks
+		// interval apart from it until a selected weekday comes up
+		anchorWeek := startOfWeek(anchor)
+		for day := 1; day <= 7*(r.interval+1); day++ {
+			candidate := anchor.AddDate(0, 0, day)
+			weeks := daysBetween(anchorWeek, startOfWeek(candidate)) / 7
+			if weeks%r.interval == 0 && r.weekdays&(1<<candidate.Weekday()) != 0 {
+				return candidate
+			}
+		}
+		return anchor.AddDate(0, 0, 7*r.interval)
+
+	case recurrenceMonthly:
+		return addMonthsClamped(anchor, r.interval)
+
+	default:
+		return addMonthsClamped(anchor, 12*r.interval)
+	}
+}
+
+// addMonthsClamped adds months to t, clamping the day to the end of the target
+// month so that e.g. January 31st plus one month is the last day of February
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstOfTarget := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfTarget.AddDate(0, 1, -1).Day()
+	return firstOfTarget.AddDate(0, 0, min(day, lastDay)-1)
+}
+
+// startOfDay returns midnight at the start of t's day
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// startOfWeek returns midnight at the start of the Monday of t's week
+func startOfWeek(t time.Time) time.Time {
+	return startOfDay(t).AddDate(0, 0, -(int(t.Weekday())+6)%7)
+}
+
+// daysBetween returns the number of calendar days from a to b, ignoring DST changes
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	days := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)).Hours() / 24
+	return int(days)
+}
+
+// recurrenceFromProto converts a protobuf repeat rule, applying its defaults
+func recurrenceFromProto(rule *pb.RecurrenceRule) recurrence {
+	r := recurrence{
+		interval: int(rule.Interval),
+		mode:     recurrenceModeFixed,
+	}
+	if r.interval == 0 {
+		r.interval = 1
+	}
+	if rule.Mode == pb.RecurrenceMode_RECURRENCE_MODE_AFTER_COMPLETION {
+		r.mode = recurrenceModeAfterCompletion
+	}
+
+	switch rule.Frequency {
+	case pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY:
+		r.frequency = recurrenceDaily
+	case pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY:
+		r.frequency = recurrenceWeekly
+	case pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY:
+		r.frequency = recurrenceMonthly
+	default:
+		r.frequency = recurrenceYearly
+	}
+
+	for _, weekday := range rule.Weekdays {
+		// The protobuf enum starts at Monday; time.Weekday starts at Sunday
+		r.weekdays |= 1 << (int(weekday) % 7)
+	}
+	return r
+}
+
+// recurrenceFromDB converts a database series into a repeat rule
+func recurrenceFromDB(series db.TaskSeries) recurrence {
+	return recurrence{
+		frequency: series.Frequency,
+		interval:  int(series.IntervalCount),
+		weekdays:  uint8(series.Weekdays),
+		mode:      series.Mode,
+	}
+}
+
+// toProto converts a repeat rule to its protobuf representation
+func (r recurrence) toProto() *pb.RecurrenceRule {
+	rule := &pb.RecurrenceRule{
+		Interval: int32(r.interval),
+		Mode:     pb.RecurrenceMode_RECURRENCE_MODE_FIXED_SCHEDULE,
+	}
+	if r.mode == recurrenceModeAfterCompletion {
+		rule.Mode = pb.RecurrenceMode_RECURRENCE_MODE_AFTER_COMPLETION
+	}
+
+	switch r.frequency {
+	case recurrenceDaily:
+		rule.Frequency = pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY
+	case recurrenceWeekly:
+		rule.Frequency = pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY
+	case recurrenceMonthly:
+		rule.Frequency = pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY
+	case recurrenceYearly:
+		rule.Frequency = pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY
+	}
+
+	// List weekdays Monday first, matching the protobuf enum
+	for weekday := pb.Weekday_WEEKDAY_MONDAY; weekday <= pb.Weekday_WEEKDAY_SUNDAY; weekday++ {
+		if r.weekdays&(1<<(int(weekday)%7)) != 0 {
+			rule.Weekdays = append(rule.Weekdays, weekday)
+		}
+	}
+	return rule
+}
@@ -0,0 +1,187 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+func TestRecurrenceNextDates(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	date := func(t time.Time) sql.NullTime {
+		return sql.NullTime{Time: t, Valid: true}
+	}
+	const (
+		monday    = 1 << time.Monday
+		wednesday = 1 << time.Wednesday
+		friday    = 1 << time.Friday
+	)
+
+	tests := []struct {
+		name        string
+		rule        recurrence
+		due, start  sql.NullTime
+		completedAt time.Time
+		wantDue     sql.NullTime
+		wantStart   sql.NullTime
+	}{
+		{
+			name:        "daily",
+			rule:        recurrence{frequency: recurrenceDaily, interval: 1, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 10)),
+			completedAt: day(2024, 3, 10).Add(12 * time.Hour),
+			wantDue:     date(day(2024, 3, 11)),
+		},
+		{
+			name:        "every three days completed early",
+			rule:        recurrence{frequency: recurrenceDaily, interval: 3, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 10)),
+			completedAt: day(2024, 3, 9),
+			wantDue:     date(day(2024, 3, 13)),
+		},
+		{
+			name:        "every other week",
+			rule:        recurrence{frequency: recurrenceWeekly, interval: 2, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 4)),
+			completedAt: day(2024, 3, 4),
+			wantDue:     date(day(2024, 3, 18)),
+		},
+		{
+			name:        "weekdays later in the week",
+			rule:        recurrence{frequency: recurrenceWeekly, interval: 1, weekdays: monday | wednesday | friday, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 6)),
+			completedAt: day(2024, 3, 6),
+			wantDue:     date(day(2024, 3, 8)),
+		},
+		{
+			name:        "weekdays wrapping into next week",
+			rule:        recurrence{frequency: recurrenceWeekly, interval: 1, weekdays: monday | friday, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 8)),
+			completedAt: day(2024, 3, 8),
+			wantDue:     date(day(2024, 3, 11)),
+		},
+		{
+			name:        "weekdays every other week",
+			rule:        recurrence{frequency: recurrenceWeekly, interval: 2, weekdays: monday, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 4)),
+			completedAt: day(2024, 3, 4),
+			wantDue:     date(day(2024, 3, 18)),
+		},
+		{
+			name:        "monthly clamps to end of shorter month",
+			rule:        recurrence{frequency: recurrenceMonthly, interval: 1, mode: recurrenceModeFixed},
+			due:         date(day(2024, 1, 31)),
+			completedAt: day(2024, 1, 31),
+			wantDue:     date(day(2024, 2, 29)),
+		},
+		{
+			name:        "yearly from leap day",
+			rule:        recurrence{frequency: recurrenceYearly, interval: 1, mode: recurrenceModeFixed},
+			due:         date(day(2024, 2, 29)),
+			completedAt: day(2024, 2, 29),
+			wantDue:     date(day(2025, 2, 28)),
+		},
+		{
+			name:        "fixed schedule skips missed occurrences",
+			rule:        recurrence{frequency: recurrenceDaily, interval: 7, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 1)),
+			completedAt: day(2024, 3, 20),
+			wantDue:     date(day(2024, 3, 22)),
+		},
+		{
+			name:        "after completion counts from completion day",
+			rule:        recurrence{frequency: recurrenceWeekly, interval: 1, mode: recurrenceModeAfterCompletion},
+			due:         date(day(2024, 3, 1)),
+			completedAt: day(2024, 3, 20).Add(15 * time.Hour),
+			wantDue:     date(day(2024, 3, 27)),
+		},
+		{
+			name:        "start and due keep their gap",
+			rule:        recurrence{frequency: recurrenceDaily, interval: 1, mode: recurrenceModeFixed},
+			due:         date(day(2024, 3, 10)),
+			start:       date(day(2024, 3, 8)),
+			completedAt: day(2024, 3, 10),
+			wantDue:     date(day(2024, 3, 11)),
+			wantStart:   date(day(2024, 3, 9)),
+		},
+		{
+			name:        "start date only",
+			rule:        recurrence{frequency: recurrenceMonthly, interval: 1, mode: recurrenceModeFixed},
+			start:       date(day(2024, 3, 15)),
+			completedAt: day(2024, 3, 15),
+			wantStart:   date(day(2024, 4, 15)),
+		},
+		{
+			name:        "no dates counts from completion day",
+			rule:        recurrence{frequency: recurrenceDaily, interval: 1, mode: recurrenceModeFixed},
+			completedAt: day(2024, 3, 10).Add(10 * time.Hour),
+			wantDue:     date(day(2024, 3, 11)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := db.Task{DueDate: tt.due, StartDate: tt.start}
+			gotDue, gotStart := tt.rule.nextDates(task, tt.completedAt)
+			if gotDue != tt.wantDue {
+				t.Errorf("due = %v, want %v", gotDue, tt.wantDue)
+			}
+			if gotStart != tt.wantStart {
+				t.Errorf("start = %v, want %v", gotStart, tt.wantStart)
+			}
+		})
+	}
+}
+
+// Completing a repeating task, reopening it and completing it again must not
+// create a second next occurrence
+func TestCompleteTaskReopenedCreatesOneOccurrence(t *testing.T) {
+	ctx := context.Background()
+	tasks := NewTaskService(newTestStore(t))
+
+	created, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "weekly"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	id := created.Task.Id
+	if _, err := tasks.SetTaskRecurrence(ctx, &pb.SetTaskRecurrenceRequest{
+		TaskId: id,
+		Rule:   &pb.RecurrenceRule{Frequency: pb.RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY},
+	}); err != nil {
+		t.Fatalf("SetTaskRecurrence: %v", err)
+	}
+
+	first, err := tasks.CompleteTask(ctx, &pb.CompleteTaskRequest{Id: id})
+	if err != nil {
+		t.Fatalf("CompleteTask: %v", err)
+	}
+	if first.NextTask == nil {
+		t.Fatal("first completion did not create the next occurrence")
+	}
+
+	if _, err := tasks.ReopenTask(ctx, &pb.ReopenTaskRequest{Id: id}); err != nil {
+		t.Fatalf("ReopenTask: %v", err)
+	}
+	second, err := tasks.CompleteTask(ctx, &pb.CompleteTaskRequest{Id: id})
+	if err != nil {
+		t.Fatalf("CompleteTask again: %v", err)
+	}
+	if second.NextTask != nil {
+		t.Errorf("second completion created another occurrence %s", second.NextTask.Id)
+	}
+
+	open := pb.TaskStatus_TASK_STATUS_OPEN
+	list, err := tasks.ListTasks(ctx, &pb.ListTasksRequest{Status: &open})
+	if err != nil {
+		t.Fatalf("ListTasks: %v", err)
+	}
+	if len(list.Tasks) != 1 || list.Tasks[0].Id != first.NextTask.Id {
+		t.Errorf("open tasks = %d, want only the first next occurrence", len(list.Tasks))
+	}
+}
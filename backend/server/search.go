@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"strings"
+	"unicode"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Number of search results returned when the request does not ask for a
+// limit, and the most it may ask for
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchService implements the SearchService gRPC service
+type SearchService struct {
+	pb.UnimplementedSearchServiceServer
+	store *db.Store
+}
+
+// NewSearchService creates a new SearchService
+func NewSearchService(store *db.Store) *SearchService {
+	return &SearchService{
+		store: store,
+	}
+}
+
+// Search finds the areas, projects and tasks matching every word of the query,
+// best matches first. Trashed items are never returned.
+func (s *SearchService) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	query := searchWords(req.Query)
+	if query == "" {
+		return &pb.SearchResponse{}, nil
+	}
+
+	params := db.SearchParams{
+		Query:           query,
+		IncludeArchived: req.IncludeArchived,
+		Limit:           defaultSearchLimit,
+	}
+	if req.Limit > 0 {
+		params.Limit = int64(min(req.Limit, maxSearchLimit))
+	}
+	if len(req.Types) > 0 {
+		types := make([]string, len(req.Types))
+		for i, entityType := range req.Types {
+			types[i] = entityTypeToDB(entityType)
+		}
+		params.EntityTypes = sql.NullString{String: strings.Join(types, ","), Valid: true}
+	}
+	if req.AreaId != nil {
+		params.AreaID = sql.NullString{String: *req.AreaId, Valid: true}
+	}
+	if req.TaskStatus != nil && *req.TaskStatus != pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		params.TaskStatus = sql.NullString{String: taskStatusToDB(*req.TaskStatus), Valid: true}
+	}
+	if req.ProjectStatus != nil && *req.ProjectStatus != pb.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		params.ProjectStatus = sql.NullString{String: projectStatusToDB(*req.ProjectStatus), Valid: true}
+	}
+
+	rows, err := s.store.Queries.Search(ctx, params)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search: %v", err)
+	}
+
+	results := make([]*pb.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = &pb.SearchResult{
+			Type:      entityTypeFromDB(row.EntityType),
+			Id:        row.EntityID,
+			Name:      row.Name,
+			Snippet:   highlightSnippet(row.Snippet),
+			AreaId:    row.AreaID.String,
+			ProjectId: row.ProjectID.String,
+		}
+	}
+
+	return &pb.SearchResponse{
+		Results: results,
+	}, nil
+}
+
+// snippetHighlighter wraps the matched terms of an HTML-escaped snippet in
+// <mark> tags
+var snippetHighlighter = strings.NewReplacer(
+	db.SnippetMatchStart, "<mark>",
+	db.SnippetMatchEnd, "</mark>",
+)
+
+// highlightSnippet turns a snippet from the database into HTML. The stored
+// text is escaped first, so names and notes containing markup are shown as
+// written rather than rendered.
+func highlightSnippet(snippet string) string {
+	return snippetHighlighter.Replace(html.EscapeString(snippet))
+}
+
+// searchWords reduces free text to the words in it, separated by single
+// spaces. Punctuation is dropped so that user input can never be a malformed
+// query in either database's search syntax.
+func searchWords(text string) string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	return strings.Join(words, " ")
+}
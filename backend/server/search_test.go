@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Markup in names and notes is escaped in snippets, and only the matched
+// terms are wrapped in <mark>
+func TestSearchSnippetEscapesText(t *testing.T) {
+	tests := []struct {
+		name, notes string
+		query       string
+		want        string
+	}{
+		{
+			name:  "<img src=x onerror=alert(1)> report",
+			query: "report",
+			want:  "&lt;img src=x onerror=alert(1)&gt; <mark>report</mark>",
+		},
+		{
+			name:  "plain",
+			notes: `see "Q&A" <script>`,
+			query: "script",
+			want:  "see &#34;Q&amp;A&#34; &lt;<mark>script</mark>&gt;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			ctx := context.Background()
+			store := newTestStore(t)
+			if _, err := NewTaskService(store).CreateTask(ctx, &pb.CreateTaskRequest{Name: tt.name, Notes: tt.notes}); err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+
+			resp, err := NewSearchService(store).Search(ctx, &pb.SearchRequest{Query: tt.query})
+			if err != nil {
+				t.Fatalf("Search: %v", err)
+			}
+			if len(resp.Results) != 1 {
+				t.Fatalf("results = %d, want 1", len(resp.Results))
+			}
+			if got := resp.Results[0].Snippet; got != tt.want {
+				t.Errorf("snippet = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
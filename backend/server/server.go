@@ -1,9 +1,12 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"time"
 
+	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
@@ -13,14 +16,57 @@ import (
 
 // Server represents the gRPC server
 type Server struct {
-	store      *db.Store
-	grpcServer *grpc.Server
-	listener   net.Listener
+	store         *db.Store
+	grpcServer    *grpc.Server
+	listener      net.Listener
+	trashService  *TrashService
+	changeService *ChangeService
+	backupService *BackupService
+
+	// trashRetention is how long deleted items stay in the trash; zero keeps them forever
+	trashRetention time.Duration
+
+	// backupInterval is how often the database is backed up; zero only backs up on request
+	backupInterval time.Duration
+
+	// stopBackground cancels the background jobs started by Start
+	stopBackground context.CancelFunc
+}
+
+// Option configures optional behaviour of a Server
+type Option func(*Server)
+
+// WithTrashRetention permanently deletes items once they have been in the
+// trash for longer than retention. A zero retention disables the purge.
+func WithTrashRetention(retention time.Duration) Option {
+	return func(s *Server) {
+		s.trashRetention = retention
+	}
+}
+
+// WithBackups backs up the database when the server starts and every interval
+// after that, keeping the keep most recent backups. A zero interval only backs
+// up when asked through BackupService, and a zero keep never removes backups.
+func WithBackups(interval time.Duration, keep int) Option {
+	return func(s *Server) {
+		s.backupInterval = interval
+		s.backupService.keep = keep
+	}
 }
 
 // New creates a new gRPC server
-func New(store *db.Store) *Server {
-	grpcServer := grpc.NewServer()
+func New(store *db.Store, opts ...Option) *Server {
+	changeService := NewChangeService(store)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			validationUnaryInterceptor(protovalidate.GlobalValidator),
+			changeNotifyUnaryInterceptor(changeService.notifier),
+		),
+		grpc.ChainStreamInterceptor(
+			validationStreamInterceptor(protovalidate.GlobalValidator),
+		),
+	)
 
 	// Register services
 	areaService := NewAreaService(store)
@@ -32,13 +78,34 @@ func New(store *db.Store) *Server {
 	taskService := NewTaskService(store)
 	pb.RegisterTaskServiceServer(grpcServer, taskService)
 
+	tagService := NewTagService(store)
+	pb.RegisterTagServiceServer(grpcServer, tagService)
+
+	trashService := NewTrashService(store)
+	pb.RegisterTrashServiceServer(grpcServer, trashService)
+
+	searchService := NewSearchService(store)
+	pb.RegisterSearchServiceServer(grpcServer, searchService)
+
+	pb.RegisterChangeServiceServer(grpcServer, changeService)
+
+	backupService := NewBackupService(store)
+	pb.RegisterBackupServiceServer(grpcServer, backupService)
+
 	// Register reflection service for debugging
 	reflection.Register(grpcServer)
 
-	return &Server{
-		store:      store,
-		grpcServer: grpcServer,
+	s := &Server{
+		store:         store,
+		grpcServer:    grpcServer,
+		trashService:  trashService,
+		changeService: changeService,
+		backupService: backupService,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // Start starts the gRPC server on the specified address
@@ -58,11 +125,28 @@ func (s *Server) Start(address string) error {
 		}
 	}()
 
+	// Start background jobs
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopBackground = cancel
+	if s.trashRetention > 0 {
+		go s.trashService.purgeExpired(ctx, s.trashRetention)
+	}
+	if s.backupInterval > 0 {
+		go s.backupService.backupPeriodically(ctx, s.backupInterval)
+	}
+	go s.changeService.pruneChanges(ctx)
+
 	return nil
 }
 
 // Stop gracefully stops the gRPC server
 func (s *Server) Stop() {
+	if s.stopBackground != nil {
+		s.stopBackground()
+	}
+	if s.changeService != nil {
+		s.changeService.stop()
+	}
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 	}
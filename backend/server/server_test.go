@@ -0,0 +1,21 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/liamawhite/planner/backend/db"
+)
+
+// newTestStore opens a fresh, migrated SQLite database that is removed when
+// the test ends
+func newTestStore(t *testing.T) *db.Store {
+	t.Helper()
+
+	store, err := db.OpenSQLite(filepath.Join(t.TempDir(), "planner.db"))
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
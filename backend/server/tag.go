@@ -0,0 +1,363 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// TagService implements the TagService gRPC service
+type TagService struct {
+	pb.UnimplementedTagServiceServer
+	store *db.Store
+}
+
+// NewTagService creates a new TagService
+func NewTagService(store *db.Store) *TagService {
+	return &TagService{
+		store: store,
+	}
+}
+
+// CreateTag creates a new tag
+func (s *TagService) CreateTag(ctx context.Context, req *pb.CreateTagRequest) (*pb.CreateTagResponse, error) {
+	var tag db.Tag
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		id := uuid.New().String()
+
+		if err := checkTagNameAvailable(ctx, q, id, req.Name); err != nil {
+			return err
+		}
+
+		// Validate that the parent tag exists
+		if req.ParentId != "" {
+			if err := checkTagsExist(ctx, q, []string{req.ParentId}); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		var err error
+		tag, err = q.CreateTag(ctx, db.CreateTagParams{
+			ID:        id,
+			Name:      req.Name,
+			ParentID:  sql.NullString{String: req.ParentId, Valid: req.ParentId != ""},
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create tag: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTagResponse{
+		Tag: dbTagToProto(tag),
+	}, nil
+}
+
+// GetTag retrieves a tag by ID
+func (s *TagService) GetTag(ctx context.Context, req *pb.GetTagRequest) (*pb.GetTagResponse, error) {
+	tag, err := s.store.Queries.GetTag(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "tag not found: %s", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
+
+	return &pb.GetTagResponse{
+		Tag: dbTagToProto(tag),
+	}, nil
+}
+
+// ListTags lists tags one page at a time, newest first
+func (s *TagService) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	page, err := newPageRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.store.Queries.ListTags(ctx, db.ListTagsParams{
+		CursorCreatedAt: page.cursorTime(),
+		CursorID:        page.cursorID(),
+		Limit:           page.fetchLimit(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+
+	tags, nextPageToken := paginate(page, tags, func(tag db.Tag) pageCursor {
+		return pageCursor{Time: tag.CreatedAt, ID: tag.ID}
+	})
+
+	pbTags := make([]*pb.Tag, len(tags))
+	for i, tag := range tags {
+		pbTags[i] = dbTagToProto(tag)
+	}
+
+	return &pb.ListTagsResponse{
+		Tags:          pbTags,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// UpdateTag updates an existing tag
+func (s *TagService) UpdateTag(ctx context.Context, req *pb.UpdateTagRequest) (*pb.UpdateTagResponse, error) {
+	var tag db.Tag
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if tag exists
+		exists, err := q.TagExists(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check tag existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "tag not found: %s", req.Id)
+		}
+
+		var name sql.NullString
+		if req.Name != nil {
+			if err := checkTagNameAvailable(ctx, q, req.Id, *req.Name); err != nil {
+				return err
+			}
+			name = sql.NullString{String: *req.Name, Valid: true}
+		}
+
+		var parentID sql.NullString
+		if req.ParentId != nil && !req.ClearParent {
+			if err := checkTagParent(ctx, q, req.Id, *req.ParentId); err != nil {
+				return err
+			}
+			parentID = sql.NullString{String: *req.ParentId, Valid: true}
+		}
+
+		tag, err = q.UpdateTag(ctx, db.UpdateTagParams{
+			ID:        req.Id,
+			Name:      name,
+			SetParent: req.ClearParent || req.ParentId != nil,
+			ParentID:  parentID,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to update tag: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateTagResponse{
+		Tag: dbTagToProto(tag),
+	}, nil
+}
+
+// DeleteTag permanently deletes a tag, removing it from every task and project.
+// Child tags are moved up to the deleted tag's parent.
+func (s *TagService) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		tag, err := q.GetTag(ctx, req.Id)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "tag not found: %s", req.Id)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get tag: %v", err)
+		}
+
+		if err := q.ReparentTags(ctx, db.ReparentTagsParams{
+			OldParentID: sql.NullString{String: tag.ID, Valid: true},
+			NewParentID: tag.ParentID,
+			UpdatedAt:   time.Now(),
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to reparent child tags: %v", err)
+		}
+		if err := q.DeleteTaskTagsForTag(ctx, tag.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to remove tag from tasks: %v", err)
+		}
+		if err := q.DeleteProjectTagsForTag(ctx, tag.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to remove tag from projects: %v", err)
+		}
+		if err := q.DeleteTag(ctx, tag.ID); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTagResponse{
+		Success: true,
+	}, nil
+}
+
+// checkTagNameAvailable returns AlreadyExists if another tag already uses name
+func checkTagNameAvailable(ctx context.Context, q db.Querier, id, name string) error {
+	taken, err := q.TagNameTaken(ctx, db.TagNameTakenParams{ID: id, Name: name})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check tag name: %v", err)
+	}
+	if taken {
+		return status.Errorf(codes.AlreadyExists, "tag already exists: %s", name)
+	}
+	return nil
+}
+
+// checkTagParent validates that parentID exists and is not the tag itself or one
+// of its descendants, which would create a cycle
+func checkTagParent(ctx context.Context, q db.Querier, id, parentID string) error {
+	for ancestorID := parentID; ancestorID != ""; {
+		if ancestorID == id {
+			return status.Errorf(codes.FailedPrecondition, "tag %s cannot be nested under its own descendant %s", id, parentID)
+		}
+		ancestor, err := q.GetTag(ctx, ancestorID)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "tag not found: %s", ancestorID)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get tag: %v", err)
+		}
+		ancestorID = ancestor.ParentID.String
+	}
+	return nil
+}
+
+// checkTagsExist returns NotFound for the first of ids that is not an existing tag
+func checkTagsExist(ctx context.Context, q db.Querier, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	existing, err := q.ListExistingTagIDs(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check tag existence: %v", err)
+	}
+
+	found := make(map[string]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return status.Errorf(codes.NotFound, "tag not found: %s", id)
+		}
+	}
+	return nil
+}
+
+// setTaskTags replaces the tags of a task, validating that every tag exists
+func setTaskTags(ctx context.Context, q db.Querier, taskID string, tagIDs []string) error {
+	if err := checkTagsExist(ctx, q, tagIDs); err != nil {
+		return err
+	}
+	if err := q.DeleteTaskTags(ctx, taskID); err != nil {
+		return status.Errorf(codes.Internal, "failed to clear task tags: %v", err)
+	}
+	for _, tagID := range tagIDs {
+		if err := q.AddTaskTag(ctx, db.AddTaskTagParams{TaskID: taskID, TagID: tagID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to tag task: %v", err)
+		}
+	}
+	return nil
+}
+
+// setProjectTags replaces the tags of a project, validating that every tag exists
+func setProjectTags(ctx context.Context, q db.Querier, projectID string, tagIDs []string) error {
+	if err := checkTagsExist(ctx, q, tagIDs); err != nil {
+		return err
+	}
+	if err := q.DeleteProjectTags(ctx, projectID); err != nil {
+		return status.Errorf(codes.Internal, "failed to clear project tags: %v", err)
+	}
+	for _, tagID := range tagIDs {
+		if err := q.AddProjectTag(ctx, db.AddProjectTagParams{ProjectID: projectID, TagID: tagID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to tag project: %v", err)
+		}
+	}
+	return nil
+}
+
+// withTaskTags fills in the tag IDs of the given tasks with a single query
+func withTaskTags(ctx context.Context, q db.Querier, tasks ...*pb.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Task, len(tasks))
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		byID[task.Id] = task
+		ids[i] = task.Id
+	}
+
+	rows, err := q.ListTaskTags(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list task tags: %v", err)
+	}
+	for _, row := range rows {
+		task := byID[row.TaskID]
+		task.TagIds = append(task.TagIds, row.TagID)
+	}
+	return nil
+}
+
+// withProjectTags fills in the tag IDs of the given projects with a single query
+func withProjectTags(ctx context.Context, q db.Querier, projects ...*pb.Project) error {
+	if len(projects) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Project, len(projects))
+	ids := make([]string, len(projects))
+	for i, project := range projects {
+		byID[project.Id] = project
+		ids[i] = project.Id
+	}
+
+	rows, err := q.ListProjectTags(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list project tags: %v", err)
+	}
+	for _, row := range rows {
+		project := byID[row.ProjectID]
+		project.TagIds = append(project.TagIds, row.TagID)
+	}
+	return nil
+}
+
+// tagFilter converts the tag filter of a List request into the comma-separated
+// tag IDs and minimum number of matches expected by the list queries
+func tagFilter(tagIDs []string, match pb.TagMatch) (sql.NullString, int64) {
+	if len(tagIDs) == 0 {
+		return sql.NullString{}, 0
+	}
+
+	minMatches := int64(1)
+	if match == pb.TagMatch_TAG_MATCH_ALL {
+		minMatches = int64(len(tagIDs))
+	}
+	return sql.NullString{String: strings.Join(tagIDs, ","), Valid: true}, minMatches
+}
+
+// dbTagToProto converts a database tag to a protobuf tag
+func dbTagToProto(tag db.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		ParentId:  tag.ParentID.String,
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+		UpdatedAt: timestamppb.New(tag.UpdatedAt),
+	}
+}
@@ -14,6 +14,13 @@ import (
 	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
 )
 
+// Task status values as stored in the database
+const (
+	taskStatusOpen      = "open"
+	taskStatusCompleted = "completed"
+	taskStatusCancelled = "cancelled"
+)
+
 // TaskService implements the TaskService gRPC service
 type TaskService struct {
 	pb.UnimplementedTaskServiceServer
@@ -27,32 +34,54 @@ func NewTaskService(store *db.Store) *TaskService {
 	}
 }
 
-// CreateTask creates a new task
+// CreateTask creates a new task, in the Inbox if no project is given
 func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
-	if req.Name == "" {
-		return nil, status.Error(codes.InvalidArgument, "name is required")
-	}
-	if req.ProjectId == "" {
-		return nil, status.Error(codes.InvalidArgument, "project_id is required")
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		var err error
+		pbTask, err = createTask(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	return &pb.CreateTaskResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// createTask creates a task and sets its tags within q's transaction
+func createTask(ctx context.Context, q db.Querier, req *pb.CreateTaskRequest) (*pb.Task, error) {
 	// Validate that the project exists
-	projectExists, err := s.store.Queries.ProjectExists(ctx, req.ProjectId)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+	if req.ProjectId != "" {
+		projectExists, err := q.ProjectExists(ctx, req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return nil, status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+		}
 	}
-	if !projectExists {
-		return nil, status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+
+	projectID := sql.NullString{String: req.ProjectId, Valid: req.ProjectId != ""}
+	if req.HeadingId != "" {
+		if err := checkTaskHeading(ctx, q, req.HeadingId, projectID); err != nil {
+			return nil, err
+		}
 	}
 
 	now := time.Now()
 	id := uuid.New().String()
 
-	task, err := s.store.Queries.CreateTask(ctx, db.CreateTaskParams{
+	task, err := q.CreateTask(ctx, db.CreateTaskParams{
 		ID:        id,
 		Name:      req.Name,
 		Notes:     req.Notes,
-		ProjectID: req.ProjectId,
+		ProjectID: projectID,
+		DueDate:   nullTimeFromProto(req.DueDate),
+		StartDate: nullTimeFromProto(req.StartDate),
+		HeadingID: sql.NullString{String: req.HeadingId, Valid: req.HeadingId != ""},
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
@@ -60,17 +89,17 @@ func (s *TaskService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest)
 		return nil, status.Errorf(codes.Internal, "failed to create task: %v", err)
 	}
 
-	return &pb.CreateTaskResponse{
-		Task: dbTaskToProto(task),
-	}, nil
+	if err := setTaskTags(ctx, q, task.ID, req.TagIds); err != nil {
+		return nil, err
+	}
+
+	pbTask := dbTaskToProto(task)
+	pbTask.TagIds = req.TagIds
+	return pbTask, nil
 }
 
 // GetTask retrieves a task by ID
 func (s *TaskService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
-
 	task, err := s.store.Queries.GetTask(ctx, req.Id)
 	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "task not found: %s", req.Id)
@@ -79,47 +108,107 @@ func (s *TaskService) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.
 		return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
 	}
 
+	pbTask := dbTaskToProto(task)
+	if err := withTaskDetails(ctx, s.store.Queries, pbTask); err != nil {
+		return nil, err
+	}
+
 	return &pb.GetTaskResponse{
-		Task: dbTaskToProto(task),
+		Task: pbTask,
 	}, nil
 }
 
-// ListTasks lists tasks, optionally filtered by project
+// ListTasks lists tasks one page at a time, optionally filtered by project (or the Inbox), status, completion time, dates, tags and blocked state
 func (s *TaskService) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	page, err := newPageRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
 	var projectID sql.NullString
 	if req.ProjectId != nil && *req.ProjectId != "" {
 		projectID = sql.NullString{String: *req.ProjectId, Valid: true}
 	}
 
-	tasks, err := s.store.Queries.ListTasks(ctx, projectID)
+	var taskStatus sql.NullString
+	if req.Status != nil && *req.Status != pb.TaskStatus_TASK_STATUS_UNSPECIFIED {
+		taskStatus = sql.NullString{String: taskStatusToDB(*req.Status), Valid: true}
+	}
+
+	var availableAt sql.NullTime
+	if req.AvailableNow {
+		availableAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	tagIDs, tagMinMatches := tagFilter(req.TagIds, req.TagMatch)
+
+	tasks, err := s.store.Queries.ListTasks(ctx, db.ListTasksParams{
+		ProjectID:       projectID,
+		InboxOnly:       req.Inbox,
+		Status:          taskStatus,
+		CompletedAfter:  nullTimeFromProto(req.CompletedAfter),
+		CompletedBefore: nullTimeFromProto(req.CompletedBefore),
+		DueAfter:        nullTimeFromProto(req.DueAfter),
+		DueBefore:       nullTimeFromProto(req.DueBefore),
+		AvailableAt:     availableAt,
+		TagIds:          tagIDs,
+		TagMinMatches:   tagMinMatches,
+		UnblockedOnly:   req.OnlyUnblocked,
+		ManualOrder:     manualOrder(req.Order),
+		IncludeArchived: req.IncludeArchived,
+		CursorSortOrder: page.cursorSortOrder(),
+		CursorCreatedAt: page.cursorTime(),
+		CursorID:        page.cursorID(),
+		Limit:           page.fetchLimit(),
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
 	}
 
+	tasks, nextPageToken := paginate(page, tasks, func(task db.Task) pageCursor {
+		return pageCursor{Time: task.CreatedAt, ID: task.ID, SortOrder: &task.SortOrder}
+	})
+
 	pbTasks := make([]*pb.Task, len(tasks))
 	for i, task := range tasks {
 		pbTasks[i] = dbTaskToProto(task)
 	}
+	if err := withTaskDetails(ctx, s.store.Queries, pbTasks...); err != nil {
+		return nil, err
+	}
 
 	return &pb.ListTasksResponse{
-		Tasks: pbTasks,
+		Tasks:         pbTasks,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // UpdateTask updates an existing task
 func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
-	}
-
-	// Check if task exists
-	exists, err := s.store.Queries.TaskExists(ctx, req.Id)
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		var err error
+		pbTask, err = updateTask(ctx, q, req)
+		return err
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		return nil, err
 	}
-	if !exists {
+
+	return &pb.UpdateTaskResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// updateTask applies an update to a task within q's transaction
+func updateTask(ctx context.Context, q db.Querier, req *pb.UpdateTaskRequest) (*pb.Task, error) {
+	current, err := q.GetTask(ctx, req.Id)
+	if err == sql.ErrNoRows {
 		return nil, status.Errorf(codes.NotFound, "task not found: %s", req.Id)
 	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
 
 	// Prepare update parameters
 	var name sql.NullString
@@ -132,53 +221,396 @@ func (s *TaskService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest)
 		notes = sql.NullString{String: *req.Notes, Valid: true}
 	}
 
-	task, err := s.store.Queries.UpdateTask(ctx, db.UpdateTaskParams{
-		ID:        req.Id,
-		Name:      name,
-		Notes:     notes,
-		UpdatedAt: time.Now(),
+	var projectID sql.NullString
+	if req.ProjectId != nil && !req.ClearProject {
+		// Validate that the destination project exists
+		projectExists, err := q.ProjectExists(ctx, *req.ProjectId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return nil, status.Errorf(codes.NotFound, "project not found: %s", *req.ProjectId)
+		}
+		projectID = sql.NullString{String: *req.ProjectId, Valid: true}
+	}
+
+	var headingID sql.NullString
+	if req.HeadingId != nil && !req.ClearHeading {
+		// The heading must belong to the project the task ends up in
+		taskProjectID := current.ProjectID
+		if req.ProjectId != nil || req.ClearProject {
+			taskProjectID = projectID
+		}
+		if err := checkTaskHeading(ctx, q, *req.HeadingId, taskProjectID); err != nil {
+			return nil, err
+		}
+		headingID = sql.NullString{String: *req.HeadingId, Valid: true}
+	}
+
+	task, err := q.UpdateTask(ctx, db.UpdateTaskParams{
+		ID:              req.Id,
+		Name:            name,
+		Notes:           notes,
+		DueDate:         nullTimeFromProto(req.DueDate),
+		ClearDueDate:    req.ClearDueDate,
+		StartDate:       nullTimeFromProto(req.StartDate),
+		ClearStartDate:  req.ClearStartDate,
+		SetProject:      req.ProjectId != nil || req.ClearProject,
+		ProjectID:       projectID,
+		SetHeading:      req.HeadingId != nil || req.ClearHeading,
+		HeadingID:       headingID,
+		UpdatedAt:       time.Now(),
+		ExpectedVersion: nullVersion(req.ExpectedVersion),
 	})
+	if err == sql.ErrNoRows {
+		return nil, conditionalUpdateError("task", req.Id, req.ExpectedVersion)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update task: %v", err)
 	}
 
-	return &pb.UpdateTaskResponse{
-		Task: dbTaskToProto(task),
-	}, nil
+	if req.SetTags {
+		if err := setTaskTags(ctx, q, task.ID, req.TagIds); err != nil {
+			return nil, err
+		}
+	}
+
+	pbTask := dbTaskToProto(task)
+	if err := withTaskDetails(ctx, q, pbTask); err != nil {
+		return nil, err
+	}
+	return pbTask, nil
 }
 
-// DeleteTask deletes a task
+// DeleteTask moves a task to the trash
 func (s *TaskService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
-	if req.Id == "" {
-		return nil, status.Error(codes.InvalidArgument, "id is required")
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		return deleteTask(ctx, q, req)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if task exists
-	exists, err := s.store.Queries.TaskExists(ctx, req.Id)
+	return &pb.DeleteTaskResponse{
+		Success: true,
+	}, nil
+}
+
+// deleteTask moves a task to the trash within q's transaction
+func deleteTask(ctx context.Context, q db.Querier, req *pb.DeleteTaskRequest) error {
+	task, err := q.GetTask(ctx, req.Id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "task not found: %s", req.Id)
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		return status.Errorf(codes.Internal, "failed to get task: %v", err)
 	}
-	if !exists {
-		return nil, status.Errorf(codes.NotFound, "task not found: %s", req.Id)
+	if err := checkVersion("task", req.Id, req.ExpectedVersion, task.Version); err != nil {
+		return err
 	}
 
-	if err := s.store.Queries.DeleteTask(ctx, req.Id); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete task: %v", err)
+	if err := q.TrashTask(ctx, db.TrashTaskParams{
+		ID:        req.Id,
+		DeletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete task: %v", err)
 	}
+	return nil
+}
 
-	return &pb.DeleteTaskResponse{
-		Success: true,
+// CompleteTask marks an open task as completed, or cancelled if requested. A
+// task that is already in the requested state is returned unchanged, and one
+// in the other finished state must be reopened first. Completing or
+// cancelling a task that repeats creates the next occurrence of its series,
+// unless the series already has another open occurrence.
+func (s *TaskService) CompleteTask(ctx context.Context, req *pb.CompleteTaskRequest) (*pb.CompleteTaskResponse, error) {
+	newStatus := taskStatusCompleted
+	if req.Cancel {
+		newStatus = taskStatusCancelled
+	}
+
+	var pbTask, pbNext *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		pbNext = nil
+		task, err := q.GetTask(ctx, req.Id)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.Id)
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get task: %v", err)
+		}
+		// Finishing a task again keeps its original completion time, so it stays
+		// on the same day of the logbook
+		if task.Status == newStatus {
+			pbTask = dbTaskToProto(task)
+			return withTaskDetails(ctx, q, pbTask)
+		}
+		if task.Status != taskStatusOpen {
+			return status.Errorf(codes.FailedPrecondition, "task %s is already %s; reopen it first", req.Id, task.Status)
+		}
+
+		now := time.Now()
+		task, err = q.SetTaskStatus(ctx, db.SetTaskStatusParams{
+			ID:          req.Id,
+			Status:      newStatus,
+			CompletedAt: sql.NullTime{Time: now, Valid: true},
+			UpdatedAt:   now,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to complete task: %v", err)
+		}
+		pbTask = dbTaskToProto(task)
+
+		if !task.SeriesID.Valid {
+			return withTaskDetails(ctx, q, pbTask)
+		}
+
+		// A task that was completed, reopened and completed again already
+		// created the occurrence after it the first time
+		hasNext, err := q.SeriesHasOpenTask(ctx, db.SeriesHasOpenTaskParams{
+			SeriesID: task.SeriesID,
+			TaskID:   task.ID,
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task series: %v", err)
+		}
+		if hasNext {
+			return withTaskDetails(ctx, q, pbTask)
+		}
+
+		pbNext, err = createNextOccurrence(ctx, q, task, now)
+		if err != nil {
+			return err
+		}
+		return withTaskDetails(ctx, q, pbTask, pbNext)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteTaskResponse{
+		Task:     pbTask,
+		NextTask: pbNext,
+	}, nil
+}
+
+// ReopenTask marks a completed or cancelled task as open again
+func (s *TaskService) ReopenTask(ctx context.Context, req *pb.ReopenTaskRequest) (*pb.ReopenTaskResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if task exists
+		exists, err := q.TaskExists(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.Id)
+		}
+
+		task, err := q.SetTaskStatus(ctx, db.SetTaskStatusParams{
+			ID:        req.Id,
+			Status:    taskStatusOpen,
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to reopen task: %v", err)
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReopenTaskResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// FileTask moves a task into a project
+func (s *TaskService) FileTask(ctx context.Context, req *pb.FileTaskRequest) (*pb.FileTaskResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		// Check if task exists
+		exists, err := q.TaskExists(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check task existence: %v", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "task not found: %s", req.Id)
+		}
+
+		// Validate that the project exists
+		projectExists, err := q.ProjectExists(ctx, req.ProjectId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return status.Errorf(codes.NotFound, "project not found: %s", req.ProjectId)
+		}
+
+		task, err := q.SetTaskProject(ctx, db.SetTaskProjectParams{
+			ID:        req.Id,
+			ProjectID: sql.NullString{String: req.ProjectId, Valid: true},
+			UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to file task: %v", err)
+		}
+
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.FileTaskResponse{
+		Task: pbTask,
+	}, nil
+}
+
+// MoveTasks moves several tasks to a project, or to the Inbox, atomically
+func (s *TaskService) MoveTasks(ctx context.Context, req *pb.MoveTasksRequest) (*pb.MoveTasksResponse, error) {
+	projectID := sql.NullString{String: req.ProjectId, Valid: req.ProjectId != ""}
+	var pbTasks []*pb.Task
+
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		pbTasks = make([]*pb.Task, 0, len(req.TaskIds))
+
+		// Validate that the destination project exists
+		if projectID.Valid {
+			projectExists, err := q.ProjectExists(ctx, projectID.String)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+			}
+			if !projectExists {
+				return status.Errorf(codes.NotFound, "project not found: %s", projectID.String)
+			}
+		}
+
+		now := time.Now()
+		for _, id := range req.TaskIds {
+			task, err := q.SetTaskProject(ctx, db.SetTaskProjectParams{
+				ID:        id,
+				ProjectID: projectID,
+				UpdatedAt: now,
+			})
+			if err == sql.ErrNoRows {
+				return status.Errorf(codes.NotFound, "task not found: %s", id)
+			}
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to move task %s: %v", id, err)
+			}
+			pbTasks = append(pbTasks, dbTaskToProto(task))
+		}
+		return withTaskDetails(ctx, q, pbTasks...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MoveTasksResponse{
+		Tasks: pbTasks,
+	}, nil
+}
+
+// ReorderTask moves a task directly after after_id and/or before before_id in the manual order
+func (s *TaskService) ReorderTask(ctx context.Context, req *pb.ReorderTaskRequest) (*pb.ReorderTaskResponse, error) {
+	var pbTask *pb.Task
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if err := taskSortOrders(q).reorder(ctx, req.Id, req.AfterId, req.BeforeId); err != nil {
+			return err
+		}
+
+		task, err := q.GetTask(ctx, req.Id)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get task: %v", err)
+		}
+		pbTask = dbTaskToProto(task)
+		return withTaskDetails(ctx, q, pbTask)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReorderTaskResponse{
+		Task: pbTask,
 	}, nil
 }
 
+// withTaskDetails fills in the tags, checklists, repeat rules and dependencies of the given tasks
+func withTaskDetails(ctx context.Context, q db.Querier, tasks ...*pb.Task) error {
+	if err := withTaskTags(ctx, q, tasks...); err != nil {
+		return err
+	}
+	if err := withTaskChecklists(ctx, q, tasks...); err != nil {
+		return err
+	}
+	if err := withTaskRecurrence(ctx, q, tasks...); err != nil {
+		return err
+	}
+	return withTaskDependencies(ctx, q, tasks...)
+}
+
 // dbTaskToProto converts a database task to a protobuf task
 func dbTaskToProto(task db.Task) *pb.Task {
-	return &pb.Task{
+	pbTask := &pb.Task{
 		Id:        task.ID,
 		Name:      task.Name,
 		Notes:     task.Notes,
-		ProjectId: task.ProjectID,
+		ProjectId: task.ProjectID.String,
+		SeriesId:  task.SeriesID.String,
+		HeadingId: task.HeadingID.String,
 		CreatedAt: timestamppb.New(task.CreatedAt),
 		UpdatedAt: timestamppb.New(task.UpdatedAt),
+		Status:    taskStatusFromDB(task.Status),
+		Version:   task.Version,
+	}
+	if task.CompletedAt.Valid {
+		pbTask.CompletedAt = timestamppb.New(task.CompletedAt.Time)
+	}
+	if task.DueDate.Valid {
+		pbTask.DueDate = timestamppb.New(task.DueDate.Time)
+	}
+	if task.StartDate.Valid {
+		pbTask.StartDate = timestamppb.New(task.StartDate.Time)
+	}
+	return pbTask
+}
+
+// nullTimeFromProto converts an optional protobuf timestamp to a nullable database time.
+// Times are converted to local time to match how the server stores its own timestamps.
+func nullTimeFromProto(ts *timestamppb.Timestamp) sql.NullTime {
+	if ts == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: ts.AsTime().Local(), Valid: true}
+}
+
+// taskStatusToDB converts a protobuf task status to its database representation
+func taskStatusToDB(s pb.TaskStatus) string {
+	switch s {
+	case pb.TaskStatus_TASK_STATUS_COMPLETED:
+		return taskStatusCompleted
+	case pb.TaskStatus_TASK_STATUS_CANCELLED:
+		return taskStatusCancelled
+	default:
+		return taskStatusOpen
+	}
+}
+
+// taskStatusFromDB converts a database task status to its protobuf representation
+func taskStatusFromDB(s string) pb.TaskStatus {
+	switch s {
+	case taskStatusOpen:
+		return pb.TaskStatus_TASK_STATUS_OPEN
+	case taskStatusCompleted:
+		return pb.TaskStatus_TASK_STATUS_COMPLETED
+	case taskStatusCancelled:
+		return pb.TaskStatus_TASK_STATUS_CANCELLED
+	default:
+		return pb.TaskStatus_TASK_STATUS_UNSPECIFIED
 	}
 }
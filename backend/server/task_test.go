@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// Trashed tasks must not be changed by handlers that update them without
+// fetching them first
+func TestMoveTasksTrashedTaskNotFound(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+	tasks := NewTaskService(store)
+
+	area, err := NewAreaService(store).CreateArea(ctx, &pb.CreateAreaRequest{Name: "area"})
+	if err != nil {
+		t.Fatalf("CreateArea: %v", err)
+	}
+	project, err := NewProjectService(store).CreateProject(ctx, &pb.CreateProjectRequest{Name: "project", AreaId: area.Area.Id})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	created, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "trashed"})
+	if err != nil {
+		t.Fatalf("CreateTask: %v", err)
+	}
+	if _, err := tasks.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: created.Task.Id}); err != nil {
+		t.Fatalf("DeleteTask: %v", err)
+	}
+
+	_, err = tasks.MoveTasks(ctx, &pb.MoveTasksRequest{
+		TaskIds:   []string{created.Task.Id},
+		ProjectId: project.Project.Id,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("MoveTasks on a trashed task = %v, want NotFound", err)
+	}
+}
+
+// Finishing a finished task leaves it as it was, or asks for it to be reopened
+// first if it was finished the other way
+func TestCompleteTaskAlreadyFinished(t *testing.T) {
+	tests := []struct {
+		name          string
+		first, second bool
+		wantCode      codes.Code
+	}{
+		{name: "complete twice", first: false, second: false},
+		{name: "cancel twice", first: true, second: true},
+		{name: "complete cancelled", first: true, second: false, wantCode: codes.FailedPrecondition},
+		{name: "cancel completed", first: false, second: true, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			tasks := NewTaskService(newTestStore(t))
+
+			created, err := tasks.CreateTask(ctx, &pb.CreateTaskRequest{Name: "task"})
+			if err != nil {
+				t.Fatalf("CreateTask: %v", err)
+			}
+			finished, err := tasks.CompleteTask(ctx, &pb.CompleteTaskRequest{Id: created.Task.Id, Cancel: tt.first})
+			if err != nil {
+				t.Fatalf("CompleteTask: %v", err)
+			}
+
+			again, err := tasks.CompleteTask(ctx, &pb.CompleteTaskRequest{Id: created.Task.Id, Cancel: tt.second})
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("CompleteTask again = %v, want %v", err, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if !again.Task.CompletedAt.AsTime().Equal(finished.Task.CompletedAt.AsTime()) {
+				t.Errorf("completed_at = %v, want %v", again.Task.CompletedAt.AsTime(), finished.Task.CompletedAt.AsTime())
+			}
+			if again.Task.Version != finished.Task.Version {
+				t.Errorf("version = %d, want %d", again.Task.Version, finished.Task.Version)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/liamawhite/planner/backend/db"
+	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
+)
+
+// trashPurgeInterval is how often the server looks for trashed items past their retention
+const trashPurgeInterval = time.Hour
+
+// Entity type values stored in the item_type column of trash listings
+const (
+	entityTypeArea    = "area"
+	entityTypeProject = "project"
+	entityTypeTask    = "task"
+)
+
+// TrashService implements the TrashService gRPC service
+type TrashService struct {
+	pb.UnimplementedTrashServiceServer
+	store *db.Store
+}
+
+// NewTrashService creates a new TrashService
+func NewTrashService(store *db.Store) *TrashService {
+	return &TrashService{
+		store: store,
+	}
+}
+
+// ListTrash lists trashed items one page at a time, most recently deleted first
+func (s *TrashService) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	page, err := newPageRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var itemType sql.NullString
+	if req.Type != pb.EntityType_ENTITY_TYPE_UNSPECIFIED {
+		itemType = sql.NullString{String: entityTypeToDB(req.Type), Valid: true}
+	}
+
+	// The trash is sorted by deleted_at, so that is the cursor's time
+	items, err := s.store.Queries.ListTrash(ctx, db.ListTrashParams{
+		ItemType:        itemType,
+		CursorDeletedAt: page.cursorTime(),
+		CursorID:        page.cursorID(),
+		Limit:           page.fetchLimit(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trash: %v", err)
+	}
+
+	items, nextPageToken := paginate(page, items, func(item db.ListTrashRow) pageCursor {
+		return pageCursor{Time: item.DeletedAt.Time, ID: item.ID}
+	})
+
+	pbItems := make([]*pb.TrashItem, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.TrashItem{
+			Type:      entityTypeFromDB(item.ItemType),
+			Id:        item.ID,
+			Name:      item.Name,
+			DeletedAt: timestamppb.New(item.DeletedAt.Time),
+		}
+	}
+
+	return &pb.ListTrashResponse{
+		Items:         pbItems,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RestoreItem restores a trashed item together with the children that were
+// trashed along with it. An item whose parent is still in the trash cannot be
+// restored on its own.
+func (s *TrashService) RestoreItem(ctx context.Context, req *pb.RestoreItemRequest) (*pb.RestoreItemResponse, error) {
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		switch req.Type {
+		case pb.EntityType_ENTITY_TYPE_AREA:
+			return restoreArea(ctx, q, req.Id)
+		case pb.EntityType_ENTITY_TYPE_PROJECT:
+			return restoreProject(ctx, q, req.Id)
+		default:
+			return restoreTask(ctx, q, req.Id)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreItemResponse{
+		Success: true,
+	}, nil
+}
+
+// EmptyTrash permanently deletes every trashed item
+func (s *TrashService) EmptyTrash(ctx context.Context, req *pb.EmptyTrashRequest) (*pb.EmptyTrashResponse, error) {
+	resp, err := s.purge(ctx, sql.NullTime{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to empty trash: %v", err)
+	}
+	return resp, nil
+}
+
+// purgeExpired permanently deletes items that have been in the trash for longer
+// than retention, once immediately and then every trashPurgeInterval until ctx
+// is cancelled
+func (s *TrashService) purgeExpired(ctx context.Context, retention time.Duration) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		before := sql.NullTime{Time: time.Now().Add(-retention), Valid: true}
+		if _, err := s.purge(ctx, before); err != nil && ctx.Err() == nil {
+			fmt.Printf("Failed to purge trash: %v\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge permanently deletes trashed items, limited to those deleted before the
+// given time if it is set. Children go first so no row outlives its parent.
+func (s *TrashService) purge(ctx context.Context, deletedBefore sql.NullTime) (*pb.EmptyTrashResponse, error) {
+	resp := &pb.EmptyTrashResponse{}
+	err := s.store.WithTx(ctx, func(q db.Querier) error {
+		if err := q.PurgeChecklistItems(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge checklist items: %w", err)
+		}
+		if err := q.PurgeTaskDependencies(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge task dependencies: %w", err)
+		}
+		if err := q.PurgeTaskTags(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge task tags: %w", err)
+		}
+		if err := q.PurgeProjectTags(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge project tags: %w", err)
+		}
+
+		var err error
+		if resp.TasksDeleted, err = q.PurgeTasks(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge tasks: %w", err)
+		}
+		if err := q.PurgeTaskSeries(ctx); err != nil {
+			return fmt.Errorf("failed to purge task series: %w", err)
+		}
+		if err := q.PurgeHeadings(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge headings: %w", err)
+		}
+		if resp.ProjectsDeleted, err = q.PurgeProjects(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge projects: %w", err)
+		}
+		if resp.AreasDeleted, err = q.PurgeAreas(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge areas: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// restoreArea restores a trashed area with the projects and tasks trashed along with it
+func restoreArea(ctx context.Context, q db.Querier, id string) error {
+	if _, err := q.GetTrashedArea(ctx, id); err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "area not found in trash: %s", id)
+	} else if err != nil {
+		return status.Errorf(codes.Internal, "failed to get area: %v", err)
+	}
+
+	// Children are matched on the area's deleted_at, so restore them first
+	if err := q.RestoreTasksInArea(ctx, id); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore area tasks: %v", err)
+	}
+	if err := q.RestoreProjectsInArea(ctx, id); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore area projects: %v", err)
+	}
+	if err := q.RestoreArea(ctx, id); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore area: %v", err)
+	}
+	return nil
+}
+
+// restoreProject restores a trashed project with the tasks trashed along with it
+func restoreProject(ctx context.Context, q db.Querier, id string) error {
+	project, err := q.GetTrashedProject(ctx, id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "project not found in trash: %s", id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get project: %v", err)
+	}
+
+	areaExists, err := q.AreaExists(ctx, project.AreaID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check area existence: %v", err)
+	}
+	if !areaExists {
+		return status.Errorf(codes.FailedPrecondition, "area %s of project %s is in the trash; restore the area first", project.AreaID, id)
+	}
+
+	// Tasks are matched on the project's deleted_at, so restore them first
+	if err := q.RestoreTasksInProject(ctx, sql.NullString{String: id, Valid: true}); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore project tasks: %v", err)
+	}
+	if err := q.RestoreProject(ctx, id); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore project: %v", err)
+	}
+	return nil
+}
+
+// restoreTask restores a trashed task
+func restoreTask(ctx context.Context, q db.Querier, id string) error {
+	task, err := q.GetTrashedTask(ctx, id)
+	if err == sql.ErrNoRows {
+		return status.Errorf(codes.NotFound, "task not found in trash: %s", id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
+
+	if task.ProjectID.Valid {
+		projectExists, err := q.ProjectExists(ctx, task.ProjectID.String)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to check project existence: %v", err)
+		}
+		if !projectExists {
+			return status.Errorf(codes.FailedPrecondition, "project %s of task %s is in the trash; restore the project first", task.ProjectID.String, id)
+		}
+	}
+
+	if err := q.RestoreTask(ctx, id); err != nil {
+		return status.Errorf(codes.Internal, "failed to restore task: %v", err)
+	}
+	return nil
+}
+
+// entityTypeToDB converts a protobuf entity type to its database representation
+func entityTypeToDB(entityType pb.EntityType) string {
+	switch entityType {
+	case pb.EntityType_ENTITY_TYPE_AREA:
+		return entityTypeArea
+	case pb.EntityType_ENTITY_TYPE_PROJECT:
+		return entityTypeProject
+	default:
+		return entityTypeTask
+	}
+}
+
+// entityTypeFromDB converts a database entity type to its protobuf representation
+func entityTypeFromDB(entityType string) pb.EntityType {
+	switch entityType {
+	case entityTypeArea:
+		return pb.EntityType_ENTITY_TYPE_AREA
+	case entityTypeProject:
+		return pb.EntityType_ENTITY_TYPE_PROJECT
+	case entityTypeTask:
+		return pb.EntityType_ENTITY_TYPE_TASK
+	default:
+		return pb.EntityType_ENTITY_TYPE_UNSPECIFIED
+	}
+}
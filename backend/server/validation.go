@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// validationUnaryInterceptor rejects requests that violate their protovalidate rules
+// before they reach a service handler
+func validationUnaryInterceptor(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if err := validator.Validate(msg); err != nil {
+				return nil, validationErrorToStatus(err)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// validationStreamInterceptor applies the same rules as validationUnaryInterceptor
+// to every message a stream receives
+func validationStreamInterceptor(validator protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: ss, validator: validator})
+	}
+}
+
+// validatingServerStream validates each message as it is received
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator protovalidate.Validator
+}
+
+// RecvMsg receives the next message and rejects it if it breaks its rules
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if err := s.validator.Validate(msg); err != nil {
+			return validationErrorToStatus(err)
+		}
+	}
+	return nil
+}
+
+// validationErrorToStatus converts a protovalidate error into a gRPC status.
+// Rule violations become InvalidArgument with a BadRequest detail listing each
+// offending field; failures to evaluate the rules themselves are Internal.
+func validationErrorToStatus(err error) error {
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return status.Errorf(codes.Internal, "failed to validate request: %v", err)
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+			Description: violation.Proto.GetMessage(),
+			Reason:      violation.Proto.GetRuleId(),
+		})
+	}
+
+	st, detailErr := status.New(codes.InvalidArgument, validationErr.Error()).WithDetails(badRequest)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, validationErr.Error())
+	}
+	return st.Err()
+}
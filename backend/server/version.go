@@ -0,0 +1,34 @@
+package server
+
+import (
+	"database/sql"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// checkVersion returns an Aborted status if the caller expected an item to be
+// at a different version from the one it is at, or nil if it expected nothing
+func checkVersion(kind, id string, expected *int64, current int64) error {
+	if expected == nil || *expected == current {
+		return nil
+	}
+	return status.Errorf(codes.Aborted, "%s %s is at version %d, not %d; fetch it again and retry", kind, id, current, *expected)
+}
+
+// conditionalUpdateError explains why an update matched no row: with an
+// expected version the item has moved past it, and otherwise it is gone
+func conditionalUpdateError(kind, id string, expected *int64) error {
+	if expected != nil {
+		return status.Errorf(codes.Aborted, "%s %s is no longer at version %d; fetch it again and retry", kind, id, *expected)
+	}
+	return status.Errorf(codes.NotFound, "%s not found: %s", kind, id)
+}
+
+// nullVersion converts an optional expected version to its database representation
+func nullVersion(v *int64) sql.NullInt64 {
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
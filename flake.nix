@@ -43,6 +43,9 @@
             # Set CGO flags to use the Nix-provided SDK
             export CGO_ENABLED=1
 
+            # SQLite full-text search needs go-sqlite3 built with FTS5
+            export GOFLAGS="-tags=sqlite_fts5"
+
             # For macOS, set up the SDK path
             ${pkgs.lib.optionalString pkgs.stdenv.isDarwin ''
               export CGO_CFLAGS="-Wno-nullability-completeness"
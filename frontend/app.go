@@ -4,6 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/liamawhite/planner/backend/config"
 	"github.com/liamawhite/planner/backend/db"
@@ -12,6 +17,18 @@ import (
 	pb "github.com/liamawhite/planner/backend/gen/planner/v1"
 )
 
+// Events relayChanges emits to the UI
+const (
+	// changesEvent carries a batch of changes as an array of Change objects
+	changesEvent = "changes"
+
+	// changesResetEvent means changes were missed, so views should reload everything
+	changesResetEvent = "changes:reset"
+)
+
+// changesRetryDelay is how long relayChanges waits before watching again after the watch drops
+const changesRetryDelay = 2 * time.Second
+
 // App struct
 type App struct {
 	ctx    context.Context
@@ -19,6 +36,9 @@ type App struct {
 	store  *db.Store
 	server *server.Server
 	client *client.Client
+
+	// stopRelay stops relaying changes to the UI
+	stopRelay context.CancelFunc
 }
 
 // NewApp creates a new App application struct
@@ -35,6 +55,11 @@ func NewApp(cfg *config.Config, store *db.Store, srv *server.Server, cl *client.
 // so we can call the runtime methods
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
+
+	relayCtx, cancel := context.WithCancel(ctx)
+	a.stopRelay = cancel
+	go a.relayChanges(relayCtx)
+
 	log.Println("Planner application started successfully")
 }
 
@@ -42,6 +67,10 @@ func (a *App) startup(ctx context.Context) {
 func (a *App) shutdown(ctx context.Context) {
 	log.Println("Shutting down Planner application...")
 
+	if a.stopRelay != nil {
+		a.stopRelay()
+	}
+
 	if a.client != nil {
 		a.client.Close()
 	}
@@ -55,6 +84,36 @@ func (a *App) shutdown(ctx context.Context) {
 	}
 }
 
+// relayChanges forwards the server's change feed to the UI as Wails events
+// until ctx is cancelled. When the watch drops it resumes from the last cursor
+// it saw, or starts afresh with a reset event if that cursor has expired.
+func (a *App) relayChanges(ctx context.Context) {
+	cursor := ""
+	for {
+		for resp, err := range a.client.WatchChanges(ctx, cursor) {
+			if err != nil {
+				if status.Code(err) == codes.FailedPrecondition {
+					cursor = ""
+					runtime.EventsEmit(a.ctx, changesResetEvent)
+				} else if ctx.Err() == nil {
+					log.Printf("Failed to watch changes: %v", err)
+				}
+				break
+			}
+			cursor = resp.Cursor
+			if len(resp.Changes) > 0 {
+				runtime.EventsEmit(a.ctx, changesEvent, resp.Changes)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changesRetryDelay):
+		}
+	}
+}
+
 // Greet returns a greeting for the given name (legacy method for demo)
 func (a *App) Greet(name string) string {
 	return fmt.Sprintf("Hello %s, It's show time!", name)
@@ -70,19 +129,35 @@ func (a *App) GetArea(id string) (*pb.Area, error) {
 	return a.client.GetArea(a.ctx, id)
 }
 
-// ListAreas lists all areas
-func (a *App) ListAreas() ([]*pb.Area, error) {
-	return a.client.ListAreas(a.ctx)
+// ListAreas lists the areas matching the filters in req, in the order it asks for
+func (a *App) ListAreas(req *pb.ListAreasRequest) ([]*pb.Area, error) {
+	return a.client.ListAreas(a.ctx, req)
+}
+
+// ReorderArea moves an area directly after afterID and/or before beforeID; either may be empty
+func (a *App) ReorderArea(id, afterID, beforeID string) (*pb.Area, error) {
+	return a.client.ReorderArea(a.ctx, id, afterID, beforeID)
+}
+
+// ArchiveArea archives an area, hiding it and its projects and tasks from lists
+func (a *App) ArchiveArea(id string) (*pb.Area, error) {
+	return a.client.ArchiveArea(a.ctx, id)
+}
+
+// UnarchiveArea unarchives an area
+func (a *App) UnarchiveArea(id string) (*pb.Area, error) {
+	return a.client.UnarchiveArea(a.ctx, id)
 }
 
-// UpdateArea updates an existing area
-func (a *App) UpdateArea(id string, name, description *string) (*pb.Area, error) {
-	return a.client.UpdateArea(a.ctx, id, name, description)
+// UpdateArea updates the fields of an area set in req, failing if it has
+// changed since expected_version when that is set
+func (a *App) UpdateArea(req *pb.UpdateAreaRequest) (*pb.Area, error) {
+	return a.client.UpdateArea(a.ctx, req)
 }
 
-// DeleteArea deletes an area
-func (a *App) DeleteArea(id string) error {
-	return a.client.DeleteArea(a.ctx, id)
+// DeleteArea deletes an area, handling its projects according to the mode of req
+func (a *App) DeleteArea(req *pb.DeleteAreaRequest) error {
+	return a.client.DeleteArea(a.ctx, req)
 }
 
 // CreateProject creates a new project
@@ -95,22 +170,93 @@ func (a *App) GetProject(id string) (*pb.Project, error) {
 	return a.client.GetProject(a.ctx, id)
 }
 
-// ListProjects lists projects, optionally filtered by area
-func (a *App) ListProjects(areaID *string) ([]*pb.Project, error) {
-	return a.client.ListProjects(a.ctx, areaID)
+// ListProjects lists the projects matching the filters in req, in the order it asks for
+func (a *App) ListProjects(req *pb.ListProjectsRequest) ([]*pb.Project, error) {
+	return a.client.ListProjects(a.ctx, req)
+}
+
+// ReorderProject moves a project directly after afterID and/or before beforeID; either may be empty
+func (a *App) ReorderProject(id, afterID, beforeID string) (*pb.Project, error) {
+	return a.client.ReorderProject(a.ctx, id, afterID, beforeID)
+}
+
+// CompleteProject marks a project as completed, handling its open tasks according to openTasks
+func (a *App) CompleteProject(id string, openTasks pb.OpenTaskAction) (*pb.Project, error) {
+	return a.client.CompleteProject(a.ctx, id, openTasks)
+}
+
+// DeferProject parks an active project for some day
+func (a *App) DeferProject(id string) (*pb.Project, error) {
+	return a.client.DeferProject(a.ctx, id)
 }
 
-// UpdateProject updates an existing project
-func (a *App) UpdateProject(id string, name, notes *string) (*pb.Project, error) {
-	return a.client.UpdateProject(a.ctx, id, name, notes)
+// ArchiveProject puts a project away for reference
+func (a *App) ArchiveProject(id string) (*pb.Project, error) {
+	return a.client.ArchiveProject(a.ctx, id)
 }
 
-// DeleteProject deletes a project
-func (a *App) DeleteProject(id string) error {
-	return a.client.DeleteProject(a.ctx, id)
+// ActivateProject makes a someday, completed or archived project active again
+func (a *App) ActivateProject(id string) (*pb.Project, error) {
+	return a.client.ActivateProject(a.ctx, id)
 }
 
-// CreateTask creates a new task
+// UpdateProject updates the fields of a project set in req, failing if it has
+// changed since expected_version when that is set
+func (a *App) UpdateProject(req *pb.UpdateProjectRequest) (*pb.Project, error) {
+	return a.client.UpdateProject(a.ctx, req)
+}
+
+// DeleteProject deletes a project, handling its tasks according to the mode of req
+func (a *App) DeleteProject(req *pb.DeleteProjectRequest) error {
+	return a.client.DeleteProject(a.ctx, req)
+}
+
+// BatchCreateProjects creates several projects at once; either all are created or none
+func (a *App) BatchCreateProjects(reqs []*pb.CreateProjectRequest) ([]*pb.Project, error) {
+	return a.client.BatchCreateProjects(a.ctx, reqs)
+}
+
+// BatchUpdateProjects updates several projects at once; either all are updated or none
+func (a *App) BatchUpdateProjects(reqs []*pb.UpdateProjectRequest) ([]*pb.Project, error) {
+	return a.client.BatchUpdateProjects(a.ctx, reqs)
+}
+
+// BatchDeleteProjects deletes several projects at once; either all are deleted or none
+func (a *App) BatchDeleteProjects(reqs []*pb.DeleteProjectRequest) error {
+	return a.client.BatchDeleteProjects(a.ctx, reqs)
+}
+
+// GetProjectOutline returns a project's tasks grouped under its headings
+func (a *App) GetProjectOutline(projectID string, includeFinished bool) (*pb.GetProjectOutlineResponse, error) {
+	return a.client.GetProjectOutline(a.ctx, projectID, includeFinished)
+}
+
+// CreateHeading adds a heading to the end of a project
+func (a *App) CreateHeading(projectID, name string) (*pb.Heading, error) {
+	return a.client.CreateHeading(a.ctx, projectID, name)
+}
+
+// RenameHeading renames a heading
+func (a *App) RenameHeading(id, name string) (*pb.Heading, error) {
+	return a.client.RenameHeading(a.ctx, id, name)
+}
+
+// ReorderHeading moves a heading directly after afterID and/or before beforeID; either may be empty
+func (a *App) ReorderHeading(id, afterID, beforeID string) (*pb.Heading, error) {
+	return a.client.ReorderHeading(a.ctx, id, afterID, beforeID)
+}
+
+// MoveHeading moves a heading and all of its tasks to another project
+func (a *App) MoveHeading(id, projectID string) (*pb.Heading, error) {
+	return a.client.MoveHeading(a.ctx, id, projectID)
+}
+
+// DeleteHeading deletes a heading, leaving its tasks in the project
+func (a *App) DeleteHeading(id string) error {
+	return a.client.DeleteHeading(a.ctx, id)
+}
+
+// CreateTask creates a new task; an empty projectID captures it in the Inbox
 func (a *App) CreateTask(name, notes, projectID string) (*pb.Task, error) {
 	return a.client.CreateTask(a.ctx, name, notes, projectID)
 }
@@ -120,17 +266,159 @@ func (a *App) GetTask(id string) (*pb.Task, error) {
 	return a.client.GetTask(a.ctx, id)
 }
 
-// ListTasks lists tasks, optionally filtered by project
-func (a *App) ListTasks(projectID *string) ([]*pb.Task, error) {
-	return a.client.ListTasks(a.ctx, projectID)
+// ListTasks lists the tasks matching the filters in req, in the order it asks for
+func (a *App) ListTasks(req *pb.ListTasksRequest) ([]*pb.Task, error) {
+	return a.client.ListTasks(a.ctx, req)
+}
+
+// ReorderTask moves a task directly after afterID and/or before beforeID; either may be empty
+func (a *App) ReorderTask(id, afterID, beforeID string) (*pb.Task, error) {
+	return a.client.ReorderTask(a.ctx, id, afterID, beforeID)
+}
+
+// UpdateTask updates the fields of a task set in req, failing if it has
+// changed since expected_version when that is set
+func (a *App) UpdateTask(req *pb.UpdateTaskRequest) (*pb.Task, error) {
+	return a.client.UpdateTask(a.ctx, req)
+}
+
+// DeleteTask deletes a task, failing if it has changed since expected_version
+// when that is set
+func (a *App) DeleteTask(req *pb.DeleteTaskRequest) error {
+	return a.client.DeleteTask(a.ctx, req)
+}
+
+// BatchCreateTasks creates several tasks at once; either all are created or none
+func (a *App) BatchCreateTasks(reqs []*pb.CreateTaskRequest) ([]*pb.Task, error) {
+	return a.client.BatchCreateTasks(a.ctx, reqs)
+}
+
+// BatchUpdateTasks updates several tasks at once; either all are updated or none
+func (a *App) BatchUpdateTasks(reqs []*pb.UpdateTaskRequest) ([]*pb.Task, error) {
+	return a.client.BatchUpdateTasks(a.ctx, reqs)
+}
+
+// BatchDeleteTasks deletes several tasks at once; either all are deleted or none
+func (a *App) BatchDeleteTasks(reqs []*pb.DeleteTaskRequest) error {
+	return a.client.BatchDeleteTasks(a.ctx, reqs)
+}
+
+// CompleteTask marks a task as completed, or as cancelled if req asks to cancel it
+func (a *App) CompleteTask(req *pb.CompleteTaskRequest) (*pb.Task, error) {
+	return a.client.CompleteTask(a.ctx, req)
+}
+
+// ReopenTask marks a completed or cancelled task as open again
+func (a *App) ReopenTask(id string) (*pb.Task, error) {
+	return a.client.ReopenTask(a.ctx, id)
+}
+
+// FileTask moves a task (typically from the Inbox) into a project
+func (a *App) FileTask(id, projectID string) (*pb.Task, error) {
+	return a.client.FileTask(a.ctx, id, projectID)
+}
+
+// MoveTasks moves several tasks to a project in one transaction; an empty projectID moves them to the Inbox
+func (a *App) MoveTasks(ids []string, projectID string) ([]*pb.Task, error) {
+	return a.client.MoveTasks(a.ctx, ids, projectID)
+}
+
+// CreateChecklistItem adds an item to the end of a task's checklist
+func (a *App) CreateChecklistItem(taskID, name string) (*pb.ChecklistItem, error) {
+	return a.client.CreateChecklistItem(a.ctx, taskID, name)
+}
+
+// ToggleChecklistItem ticks off or unticks a checklist item
+func (a *App) ToggleChecklistItem(id string, completed bool) (*pb.ChecklistItem, error) {
+	return a.client.ToggleChecklistItem(a.ctx, id, completed)
+}
+
+// ReorderChecklistItems puts a task's checklist into the order of itemIDs, which must list every item
+func (a *App) ReorderChecklistItems(taskID string, itemIDs []string) ([]*pb.ChecklistItem, error) {
+	return a.client.ReorderChecklistItems(a.ctx, taskID, itemIDs)
+}
+
+// DeleteChecklistItem deletes a checklist item
+func (a *App) DeleteChecklistItem(id string) error {
+	return a.client.DeleteChecklistItem(a.ctx, id)
+}
+
+// SetTaskRecurrence makes a task repeat by rule, or changes the rule of its series
+func (a *App) SetTaskRecurrence(taskID string, rule *pb.RecurrenceRule) (*pb.Task, error) {
+	return a.client.SetTaskRecurrence(a.ctx, taskID, rule)
+}
+
+// SkipTaskOccurrence moves a recurring task to the dates of its next occurrence
+func (a *App) SkipTaskOccurrence(taskID string) (*pb.Task, error) {
+	return a.client.SkipTaskOccurrence(a.ctx, taskID)
+}
+
+// StopTaskRecurrence stops a task from repeating once it is completed
+func (a *App) StopTaskRecurrence(taskID string) (*pb.Task, error) {
+	return a.client.StopTaskRecurrence(a.ctx, taskID)
+}
+
+// AddTaskDependency makes a task depend on another
+func (a *App) AddTaskDependency(taskID, dependsOnID string) (*pb.Task, error) {
+	return a.client.AddTaskDependency(a.ctx, taskID, dependsOnID)
+}
+
+// RemoveTaskDependency removes a dependency between two tasks
+func (a *App) RemoveTaskDependency(taskID, dependsOnID string) (*pb.Task, error) {
+	return a.client.RemoveTaskDependency(a.ctx, taskID, dependsOnID)
+}
+
+// CreateTag creates a new tag, nested under parentID if it is not empty
+func (a *App) CreateTag(name, parentID string) (*pb.Tag, error) {
+	return a.client.CreateTag(a.ctx, name, parentID)
+}
+
+// GetTag retrieves a tag by ID
+func (a *App) GetTag(id string) (*pb.Tag, error) {
+	return a.client.GetTag(a.ctx, id)
+}
+
+// ListTags lists all tags
+func (a *App) ListTags() ([]*pb.Tag, error) {
+	return a.client.ListTags(a.ctx)
+}
+
+// UpdateTag renames a tag or moves it under another tag, as set in req
+func (a *App) UpdateTag(req *pb.UpdateTagRequest) (*pb.Tag, error) {
+	return a.client.UpdateTag(a.ctx, req)
+}
+
+// DeleteTag deletes a tag, removing it from all tasks and projects
+func (a *App) DeleteTag(id string) error {
+	return a.client.DeleteTag(a.ctx, id)
+}
+
+// ListTrash lists the items in the trash, most recently deleted first
+func (a *App) ListTrash() ([]*pb.TrashItem, error) {
+	return a.client.ListTrash(a.ctx)
+}
+
+// RestoreItem restores an item, and the children deleted along with it, from the trash
+func (a *App) RestoreItem(entityType pb.EntityType, id string) error {
+	return a.client.RestoreItem(a.ctx, entityType, id)
+}
+
+// EmptyTrash permanently deletes everything in the trash
+func (a *App) EmptyTrash() (*pb.EmptyTrashResponse, error) {
+	return a.client.EmptyTrash(a.ctx)
+}
+
+// Search finds the areas, projects and tasks matching the filters in req, best matches first
+func (a *App) Search(req *pb.SearchRequest) ([]*pb.SearchResult, error) {
+	return a.client.Search(a.ctx, req)
 }
 
-// UpdateTask updates an existing task
-func (a *App) UpdateTask(id string, name, notes *string) (*pb.Task, error) {
-	return a.client.UpdateTask(a.ctx, id, name, notes)
+// CreateBackup backs up the database now
+func (a *App) CreateBackup() (*pb.Backup, error) {
+	return a.client.CreateBackup(a.ctx)
 }
 
-// DeleteTask deletes a task
-func (a *App) DeleteTask(id string) error {
-	return a.client.DeleteTask(a.ctx, id)
+// ListBackups lists the backups the server has kept, most recent first
+func (a *App) ListBackups() ([]*pb.Backup, error) {
+	return a.client.ListBackups(a.ctx)
 }
@@ -31,14 +31,12 @@ func main() {
 	var store *db.Store
 	switch cfg.Database.Type {
 	case "sqlite":
-		// Backup existing database before opening
-		if err := db.BackupSQLite(cfg.Database.Path, 7); err != nil {
-			log.Printf("Warning: Failed to backup database: %v", err)
-		} else {
-			log.Printf("Database backup created successfully")
-		}
-
-		store, err = db.OpenSQLite(cfg.Database.Path)
+		store, err = db.OpenSQLite(cfg.Database.Path,
+			db.WithForeignKeys(cfg.Database.SQLite.ForeignKeys),
+			db.WithJournalMode(cfg.Database.SQLite.JournalMode),
+			db.WithSynchronous(cfg.Database.SQLite.Synchronous),
+			db.WithBusyTimeout(cfg.Database.SQLite.BusyTimeout),
+		)
 		if err != nil {
 			log.Fatalf("Failed to open database: %v", err)
 		}
@@ -58,7 +56,10 @@ func main() {
 	// Start gRPC server for in-process mode
 	var srv *server.Server
 	if cfg.Mode == config.ModeInProcess {
-		srv = server.New(store)
+		srv = server.New(store,
+			server.WithTrashRetention(cfg.Trash.Retention),
+			server.WithBackups(cfg.Backup.Interval, cfg.Backup.Keep),
+		)
 		serverAddr := cfg.ServerAddress()
 
 		if err := srv.Start(serverAddr); err != nil {
@@ -1,5 +1,6 @@
 import { useState, useEffect } from 'react'
-import { CreateArea, ListAreas, UpdateArea, DeleteArea, ListProjects } from '../../wailsjs/go/main/App'
+import { CreateArea, ListAreas, UpdateArea, DeleteArea, ArchiveArea, UnarchiveArea, ListProjects } from '../../wailsjs/go/main/App'
+import { EventsOn } from '../../wailsjs/runtime/runtime'
 import { Button } from '@/components/ui/button'
 import { Input } from '@/components/ui/input'
 
@@ -9,22 +10,47 @@ interface Area {
   description: string
   created_at: any
   updated_at: any
+  archived_at?: any
+  version: number
 }
 
+// Change is a change relayed from the backend's change feed
+interface Change {
+  cursor: string
+  type?: number
+  id: string
+  operation?: number
+}
+
+const ENTITY_TYPE_AREA = 1
+
 export default function Areas() {
   const [areas, setAreas] = useState<Area[]>([])
   const [name, setName] = useState('')
   const [description, setDescription] = useState('')
   const [editingId, setEditingId] = useState<string | null>(null)
+  const [editingVersion, setEditingVersion] = useState(0)
   const [error, setError] = useState('')
 
   useEffect(() => {
     loadAreas()
+
+    // Pick up areas changed anywhere else, such as another client of the same server
+    const offChanges = EventsOn('changes', (changes: Change[]) => {
+      if (changes.some((change) => change.type === ENTITY_TYPE_AREA)) {
+        loadAreas()
+      }
+    })
+    const offReset = EventsOn('changes:reset', loadAreas)
+    return () => {
+      offChanges()
+      offReset()
+    }
   }, [])
 
   async function loadAreas() {
     try {
-      const result = await ListAreas()
+      const result = await ListAreas({ include_archived: true })
       setAreas(result || [])
       setError('')
     } catch (err) {
@@ -37,7 +63,12 @@ export default function Areas() {
 
     try {
       if (editingId) {
-        await UpdateArea(editingId, name || null, description || null)
+        await UpdateArea({
+          id: editingId,
+          name: name || null,
+          description: description || null,
+          expected_version: editingVersion
+        })
       } else {
         await CreateArea(name, description)
       }
@@ -48,6 +79,13 @@ export default function Areas() {
       setError('')
       await loadAreas()
     } catch (err) {
+      if (String(err).includes('code = Aborted')) {
+        // Someone else saved the area while it was being edited
+        setError('This area was changed elsewhere. Check the latest version and edit it again.')
+        handleCancelEdit()
+        await loadAreas()
+        return
+      }
       setError(`Operation failed: ${err}`)
     }
   }
@@ -55,7 +93,7 @@ export default function Areas() {
   async function handleDelete(id: string) {
     // Check if area has projects
     try {
-      const projects = await ListProjects(id)
+      const projects = await ListProjects({ area_id: id, include_archived: true })
       if (projects && projects.length > 0) {
         setError('Cannot delete area with existing projects. Please delete all projects first.')
         return
@@ -70,7 +108,7 @@ export default function Areas() {
     }
 
     try {
-      await DeleteArea(id)
+      await DeleteArea({ id })
       setError('')
       await loadAreas()
     } catch (err) {
@@ -78,8 +116,23 @@ export default function Areas() {
     }
   }
 
+  async function handleToggleArchived(area: Area) {
+    try {
+      if (area.archived_at) {
+        await UnarchiveArea(area.id)
+      } else {
+        await ArchiveArea(area.id)
+      }
+      setError('')
+      await loadAreas()
+    } catch (err) {
+      setError(`Archive failed: ${err}`)
+    }
+  }
+
   function handleEdit(area: Area) {
     setEditingId(area.id)
+    setEditingVersion(area.version)
     setName(area.name)
     setDescription(area.description)
   }
@@ -158,8 +211,13 @@ export default function Areas() {
                   key={area.id}
                   className="flex items-center justify-between rounded-lg border bg-card p-4 transition-colors hover:bg-accent/50"
                 >
-                  <div className="space-y-0.5">
-                    <h3 className="font-medium leading-none">{area.name}</h3>
+                  <div className={area.archived_at ? 'space-y-0.5 opacity-60' : 'space-y-0.5'}>
+                    <h3 className="font-medium leading-none">
+                      {area.name}
+                      {area.archived_at && (
+                        <span className="ml-2 text-xs font-normal text-muted-foreground">Archived</span>
+                      )}
+                    </h3>
                     {area.description && (
                       <p className="text-sm text-muted-foreground">{area.description}</p>
                     )}
@@ -176,6 +234,19 @@ export default function Areas() {
                         <path d="m15 5 4 4"/>
                       </svg>
                     </Button>
+                    <Button
+                      variant="ghost"
+                      size="icon"
+                      onClick={() => handleToggleArchived(area)}
+                      className="h-8 w-8"
+                      title={area.archived_at ? 'Unarchive' : 'Archive'}
+                    >
+                      <svg xmlns="http://www.w3.org/2000/svg" width="16" height="16" viewBox="0 0 24 24" fill="none" stroke="currentColor" strokeWidth="2" strokeLinecap="round" strokeLinejoin="round">
+                        <rect width="20" height="5" x="2" y="3" rx="1"/>
+                        <path d="M4 8v11a2 2 0 0 0 2 2h12a2 2 0 0 0 2-2V8"/>
+                        <path d="M10 12h4"/>
+                      </svg>
+                    </Button>
                     <Button
                       variant="ghost"
                       size="icon"
@@ -29,8 +29,8 @@ export default function Dashboard() {
     async function loadData() {
       try {
         const [areasData, projectsData] = await Promise.all([
-          ListAreas(),
-          ListProjects(null)
+          ListAreas({}),
+          ListProjects({})
         ])
         setAreas(areasData || [])
         setProjects(projectsData || [])
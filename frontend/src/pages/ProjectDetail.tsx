@@ -63,7 +63,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
 
   async function loadTasks() {
     try {
-      const result = await ListTasks(projectId)
+      const result = await ListTasks({ project_id: projectId })
       setTasks(result || [])
       setError('')
     } catch (err) {
@@ -76,7 +76,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
 
     try {
       if (editingTaskId) {
-        await UpdateTask(editingTaskId, taskName || null, taskNotes || null)
+        await UpdateTask({ id: editingTaskId, name: taskName || null, notes: taskNotes || null })
       } else {
         await CreateTask(taskName, taskNotes, projectId)
       }
@@ -98,7 +98,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
     }
 
     try {
-      await DeleteTask(id)
+      await DeleteTask({ id })
       setError('')
       await loadTasks()
     } catch (err) {
@@ -124,7 +124,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
     if (!project || !editingProjectName) return
 
     try {
-      await UpdateProject(project.id, editingProjectName, null)
+      await UpdateProject({ id: project.id, name: editingProjectName })
       setEditingProjectName(null)
       setError('')
       await loadProject()
@@ -147,7 +147,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
     }
 
     try {
-      await DeleteProject(project.id)
+      await DeleteProject({ id: project.id })
       navigate({ to: '/projects' })
     } catch (err) {
       setError(`Delete failed: ${err}`)
@@ -328,7 +328,7 @@ export default function ProjectDetail({ projectId }: ProjectDetailProps) {
             }}
             onBlur={async () => {
               try {
-                await UpdateProject(project.id, null, project.notes)
+                await UpdateProject({ id: project.id, notes: project.notes })
                 setError('')
               } catch (err) {
                 setError(`Failed to update notes: ${err}`)
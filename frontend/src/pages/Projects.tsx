@@ -14,11 +14,19 @@ interface Area {
   updated_at: any
 }
 
+interface ProjectProgress {
+  open_tasks?: number
+  completed_tasks?: number
+  percent_complete?: number
+  overdue_tasks?: number
+}
+
 interface Project {
   id: string
   name: string
   area_id: string
   notes: string
+  progress?: ProjectProgress
   created_at: any
   updated_at: any
 }
@@ -44,7 +52,7 @@ export default function Projects() {
 
   async function loadAreas() {
     try {
-      const result = await ListAreas()
+      const result = await ListAreas({})
       setAreas(result || [])
       setError('')
     } catch (err) {
@@ -54,7 +62,7 @@ export default function Projects() {
 
   async function loadProjects() {
     try {
-      const result = await ListProjects(filterAreaId)
+      const result = await ListProjects({ area_id: filterAreaId })
       setProjects(result || [])
       setError('')
     } catch (err) {
@@ -72,7 +80,7 @@ export default function Projects() {
 
     try {
       if (editingProjectId) {
-        await UpdateProject(editingProjectId, projectName || null, projectNotes || null)
+        await UpdateProject({ id: editingProjectId, name: projectName || null, notes: projectNotes || null })
       } else {
         await CreateProject(projectName, projectAreaId, projectNotes)
       }
@@ -91,7 +99,7 @@ export default function Projects() {
   async function handleDeleteProject(id: string) {
     // Check if project has tasks
     try {
-      const tasks = await ListTasks(id)
+      const tasks = await ListTasks({ project_id: id })
       if (tasks && tasks.length > 0) {
         setError('Cannot delete project with existing tasks. Please delete all tasks first.')
         return
@@ -106,7 +114,7 @@ export default function Projects() {
     }
 
     try {
-      await DeleteProject(id)
+      await DeleteProject({ id })
       setError('')
       await loadProjects()
     } catch (err) {
@@ -133,6 +141,19 @@ export default function Projects() {
     return area?.name || 'Unknown Area'
   }
 
+  function describeProgress(progress?: ProjectProgress): string {
+    const completed = progress?.completed_tasks ?? 0
+    const total = completed + (progress?.open_tasks ?? 0)
+    if (total === 0) {
+      return 'No tasks'
+    }
+    let text = `${completed}/${total} done (${progress?.percent_complete ?? 0}%)`
+    if (progress?.overdue_tasks) {
+      text += ` · ${progress.overdue_tasks} overdue`
+    }
+    return text
+  }
+
   return (
     <div className="max-w-5xl mx-auto p-6 space-y-8">
       <header className="space-y-1 pt-6">
@@ -258,7 +279,7 @@ export default function Projects() {
                       <h3 className="font-medium leading-none hover:underline cursor-pointer">{project.name}</h3>
                     </Link>
                     <p className="text-sm text-muted-foreground">
-                      {getAreaName(project.area_id)}
+                      {getAreaName(project.area_id)} · {describeProgress(project.progress)}
                     </p>
                   </div>
                   <div className="flex gap-1">
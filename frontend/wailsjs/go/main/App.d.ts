@@ -2,34 +2,124 @@
 // This file is automatically generated. DO NOT EDIT
 import {plannerv1} from '../models';
 
+export function ActivateProject(arg1:string):Promise<plannerv1.Project>;
+
+export function AddTaskDependency(arg1:string,arg2:string):Promise<plannerv1.Task>;
+
+export function ArchiveArea(arg1:string):Promise<plannerv1.Area>;
+
+export function ArchiveProject(arg1:string):Promise<plannerv1.Project>;
+
+export function BatchCreateProjects(arg1:Array<plannerv1.CreateProjectRequest>):Promise<Array<plannerv1.Project>>;
+
+export function BatchCreateTasks(arg1:Array<plannerv1.CreateTaskRequest>):Promise<Array<plannerv1.Task>>;
+
+export function BatchDeleteProjects(arg1:Array<plannerv1.DeleteProjectRequest>):Promise<void>;
+
+export function BatchDeleteTasks(arg1:Array<plannerv1.DeleteTaskRequest>):Promise<void>;
+
+export function BatchUpdateProjects(arg1:Array<plannerv1.UpdateProjectRequest>):Promise<Array<plannerv1.Project>>;
+
+export function BatchUpdateTasks(arg1:Array<plannerv1.UpdateTaskRequest>):Promise<Array<plannerv1.Task>>;
+
+export function CompleteProject(arg1:string,arg2:number):Promise<plannerv1.Project>;
+
+export function CompleteTask(arg1:plannerv1.CompleteTaskRequest):Promise<plannerv1.Task>;
+
 export function CreateArea(arg1:string,arg2:string):Promise<plannerv1.Area>;
 
+export function CreateBackup():Promise<plannerv1.Backup>;
+
+export function CreateChecklistItem(arg1:string,arg2:string):Promise<plannerv1.ChecklistItem>;
+
+export function CreateHeading(arg1:string,arg2:string):Promise<plannerv1.Heading>;
+
 export function CreateProject(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Project>;
 
+export function CreateTag(arg1:string,arg2:string):Promise<plannerv1.Tag>;
+
 export function CreateTask(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Task>;
 
-export function DeleteArea(arg1:string):Promise<void>;
+export function DeferProject(arg1:string):Promise<plannerv1.Project>;
+
+export function DeleteArea(arg1:plannerv1.DeleteAreaRequest):Promise<void>;
+
+export function DeleteChecklistItem(arg1:string):Promise<void>;
 
-export function DeleteProject(arg1:string):Promise<void>;
+export function DeleteHeading(arg1:string):Promise<void>;
 
-export function DeleteTask(arg1:string):Promise<void>;
+export function DeleteProject(arg1:plannerv1.DeleteProjectRequest):Promise<void>;
+
+export function DeleteTag(arg1:string):Promise<void>;
+
+export function DeleteTask(arg1:plannerv1.DeleteTaskRequest):Promise<void>;
+
+export function EmptyTrash():Promise<plannerv1.EmptyTrashResponse>;
+
+export function FileTask(arg1:string,arg2:string):Promise<plannerv1.Task>;
 
 export function GetArea(arg1:string):Promise<plannerv1.Area>;
 
 export function GetProject(arg1:string):Promise<plannerv1.Project>;
 
+export function GetProjectOutline(arg1:string,arg2:boolean):Promise<plannerv1.GetProjectOutlineResponse>;
+
+export function GetTag(arg1:string):Promise<plannerv1.Tag>;
+
 export function GetTask(arg1:string):Promise<plannerv1.Task>;
 
 export function Greet(arg1:string):Promise<string>;
 
-export function ListAreas():Promise<Array<plannerv1.Area>>;
+export function ListAreas(arg1:plannerv1.ListAreasRequest):Promise<Array<plannerv1.Area>>;
+
+export function ListBackups():Promise<Array<plannerv1.Backup>>;
+
+export function ListProjects(arg1:plannerv1.ListProjectsRequest):Promise<Array<plannerv1.Project>>;
+
+export function ListTags():Promise<Array<plannerv1.Tag>>;
+
+export function ListTasks(arg1:plannerv1.ListTasksRequest):Promise<Array<plannerv1.Task>>;
+
+export function ListTrash():Promise<Array<plannerv1.TrashItem>>;
+
+export function MoveHeading(arg1:string,arg2:string):Promise<plannerv1.Heading>;
+
+export function MoveTasks(arg1:Array<string>,arg2:string):Promise<Array<plannerv1.Task>>;
+
+export function RemoveTaskDependency(arg1:string,arg2:string):Promise<plannerv1.Task>;
+
+export function RenameHeading(arg1:string,arg2:string):Promise<plannerv1.Heading>;
+
+export function ReopenTask(arg1:string):Promise<plannerv1.Task>;
+
+export function ReorderArea(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Area>;
+
+export function ReorderChecklistItems(arg1:string,arg2:Array<string>):Promise<Array<plannerv1.ChecklistItem>>;
+
+export function ReorderHeading(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Heading>;
+
+export function ReorderProject(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Project>;
+
+export function ReorderTask(arg1:string,arg2:string,arg3:string):Promise<plannerv1.Task>;
+
+export function RestoreItem(arg1:number,arg2:string):Promise<void>;
+
+export function Search(arg1:plannerv1.SearchRequest):Promise<Array<plannerv1.SearchResult>>;
+
+export function SetTaskRecurrence(arg1:string,arg2:plannerv1.RecurrenceRule):Promise<plannerv1.Task>;
+
+export function SkipTaskOccurrence(arg1:string):Promise<plannerv1.Task>;
+
+export function StopTaskRecurrence(arg1:string):Promise<plannerv1.Task>;
+
+export function ToggleChecklistItem(arg1:string,arg2:boolean):Promise<plannerv1.ChecklistItem>;
 
-export function ListProjects(arg1:any):Promise<Array<plannerv1.Project>>;
+export function UnarchiveArea(arg1:string):Promise<plannerv1.Area>;
 
-export function ListTasks(arg1:any):Promise<Array<plannerv1.Task>>;
+export function UpdateArea(arg1:plannerv1.UpdateAreaRequest):Promise<plannerv1.Area>;
 
-export function UpdateArea(arg1:string,arg2:any,arg3:any):Promise<plannerv1.Area>;
+export function UpdateProject(arg1:plannerv1.UpdateProjectRequest):Promise<plannerv1.Project>;
 
-export function UpdateProject(arg1:string,arg2:any,arg3:any):Promise<plannerv1.Project>;
+export function UpdateTag(arg1:plannerv1.UpdateTagRequest):Promise<plannerv1.Tag>;
 
-export function UpdateTask(arg1:string,arg2:any,arg3:any):Promise<plannerv1.Task>;
+export function UpdateTask(arg1:plannerv1.UpdateTaskRequest):Promise<plannerv1.Task>;
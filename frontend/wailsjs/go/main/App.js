@@ -2,30 +2,118 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 
+export function ActivateProject(arg1) {
+  return window['go']['main']['App']['ActivateProject'](arg1);
+}
+
+export function AddTaskDependency(arg1, arg2) {
+  return window['go']['main']['App']['AddTaskDependency'](arg1, arg2);
+}
+
+export function ArchiveArea(arg1) {
+  return window['go']['main']['App']['ArchiveArea'](arg1);
+}
+
+export function ArchiveProject(arg1) {
+  return window['go']['main']['App']['ArchiveProject'](arg1);
+}
+
+export function BatchCreateProjects(arg1) {
+  return window['go']['main']['App']['BatchCreateProjects'](arg1);
+}
+
+export function BatchCreateTasks(arg1) {
+  return window['go']['main']['App']['BatchCreateTasks'](arg1);
+}
+
+export function BatchDeleteProjects(arg1) {
+  return window['go']['main']['App']['BatchDeleteProjects'](arg1);
+}
+
+export function BatchDeleteTasks(arg1) {
+  return window['go']['main']['App']['BatchDeleteTasks'](arg1);
+}
+
+export function BatchUpdateProjects(arg1) {
+  return window['go']['main']['App']['BatchUpdateProjects'](arg1);
+}
+
+export function BatchUpdateTasks(arg1) {
+  return window['go']['main']['App']['BatchUpdateTasks'](arg1);
+}
+
+export function CompleteProject(arg1, arg2) {
+  return window['go']['main']['App']['CompleteProject'](arg1, arg2);
+}
+
+export function CompleteTask(arg1) {
+  return window['go']['main']['App']['CompleteTask'](arg1);
+}
+
 export function CreateArea(arg1, arg2) {
   return window['go']['main']['App']['CreateArea'](arg1, arg2);
 }
 
+export function CreateBackup() {
+  return window['go']['main']['App']['CreateBackup']();
+}
+
+export function CreateChecklistItem(arg1, arg2) {
+  return window['go']['main']['App']['CreateChecklistItem'](arg1, arg2);
+}
+
+export function CreateHeading(arg1, arg2) {
+  return window['go']['main']['App']['CreateHeading'](arg1, arg2);
+}
+
 export function CreateProject(arg1, arg2, arg3) {
   return window['go']['main']['App']['CreateProject'](arg1, arg2, arg3);
 }
 
+export function CreateTag(arg1, arg2) {
+  return window['go']['main']['App']['CreateTag'](arg1, arg2);
+}
+
 export function CreateTask(arg1, arg2, arg3) {
   return window['go']['main']['App']['CreateTask'](arg1, arg2, arg3);
 }
 
+export function DeferProject(arg1) {
+  return window['go']['main']['App']['DeferProject'](arg1);
+}
+
 export function DeleteArea(arg1) {
   return window['go']['main']['App']['DeleteArea'](arg1);
 }
 
+export function DeleteChecklistItem(arg1) {
+  return window['go']['main']['App']['DeleteChecklistItem'](arg1);
+}
+
+export function DeleteHeading(arg1) {
+  return window['go']['main']['App']['DeleteHeading'](arg1);
+}
+
 export function DeleteProject(arg1) {
   return window['go']['main']['App']['DeleteProject'](arg1);
 }
 
+export function DeleteTag(arg1) {
+  return window['go']['main']['App']['DeleteTag'](arg1);
+}
+
 export function DeleteTask(arg1) {
   return window['go']['main']['App']['DeleteTask'](arg1);
 }
 
+export function EmptyTrash() {
+  return window['go']['main']['App']['EmptyTrash']();
+}
+
+export function FileTask(arg1, arg2) {
+  return window['go']['main']['App']['FileTask'](arg1, arg2);
+}
+
 export function GetArea(arg1) {
   return window['go']['main']['App']['GetArea'](arg1);
 }
@@ -34,6 +122,14 @@ export function GetProject(arg1) {
   return window['go']['main']['App']['GetProject'](arg1);
 }
 
+export function GetProjectOutline(arg1, arg2) {
+  return window['go']['main']['App']['GetProjectOutline'](arg1, arg2);
+}
+
+export function GetTag(arg1) {
+  return window['go']['main']['App']['GetTag'](arg1);
+}
+
 export function GetTask(arg1) {
   return window['go']['main']['App']['GetTask'](arg1);
 }
@@ -42,26 +138,110 @@ export function Greet(arg1) {
   return window['go']['main']['App']['Greet'](arg1);
 }
 
-export function ListAreas() {
-  return window['go']['main']['App']['ListAreas']();
+export function ListAreas(arg1) {
+  return window['go']['main']['App']['ListAreas'](arg1);
+}
+
+export function ListBackups() {
+  return window['go']['main']['App']['ListBackups']();
 }
 
 export function ListProjects(arg1) {
   return window['go']['main']['App']['ListProjects'](arg1);
 }
 
+export function ListTags() {
+  return window['go']['main']['App']['ListTags']();
+}
+
 export function ListTasks(arg1) {
   return window['go']['main']['App']['ListTasks'](arg1);
 }
 
-export function UpdateArea(arg1, arg2, arg3) {
-  return window['go']['main']['App']['UpdateArea'](arg1, arg2, arg3);
+export function ListTrash() {
+  return window['go']['main']['App']['ListTrash']();
+}
+
+export function MoveHeading(arg1, arg2) {
+  return window['go']['main']['App']['MoveHeading'](arg1, arg2);
+}
+
+export function MoveTasks(arg1, arg2) {
+  return window['go']['main']['App']['MoveTasks'](arg1, arg2);
+}
+
+export function RemoveTaskDependency(arg1, arg2) {
+  return window['go']['main']['App']['RemoveTaskDependency'](arg1, arg2);
+}
+
+export function RenameHeading(arg1, arg2) {
+  return window['go']['main']['App']['RenameHeading'](arg1, arg2);
+}
+
+export function ReopenTask(arg1) {
+  return window['go']['main']['App']['ReopenTask'](arg1);
+}
+
+export function ReorderArea(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReorderArea'](arg1, arg2, arg3);
+}
+
+export function ReorderChecklistItems(arg1, arg2) {
+  return window['go']['main']['App']['ReorderChecklistItems'](arg1, arg2);
+}
+
+export function ReorderHeading(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReorderHeading'](arg1, arg2, arg3);
+}
+
+export function ReorderProject(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReorderProject'](arg1, arg2, arg3);
+}
+
+export function ReorderTask(arg1, arg2, arg3) {
+  return window['go']['main']['App']['ReorderTask'](arg1, arg2, arg3);
+}
+
+export function RestoreItem(arg1, arg2) {
+  return window['go']['main']['App']['RestoreItem'](arg1, arg2);
+}
+
+export function Search(arg1) {
+  return window['go']['main']['App']['Search'](arg1);
+}
+
+export function SetTaskRecurrence(arg1, arg2) {
+  return window['go']['main']['App']['SetTaskRecurrence'](arg1, arg2);
+}
+
+export function SkipTaskOccurrence(arg1) {
+  return window['go']['main']['App']['SkipTaskOccurrence'](arg1);
+}
+
+export function StopTaskRecurrence(arg1) {
+  return window['go']['main']['App']['StopTaskRecurrence'](arg1);
+}
+
+export function ToggleChecklistItem(arg1, arg2) {
+  return window['go']['main']['App']['ToggleChecklistItem'](arg1, arg2);
+}
+
+export function UnarchiveArea(arg1) {
+  return window['go']['main']['App']['UnarchiveArea'](arg1);
+}
+
+export function UpdateArea(arg1) {
+  return window['go']['main']['App']['UpdateArea'](arg1);
+}
+
+export function UpdateProject(arg1) {
+  return window['go']['main']['App']['UpdateProject'](arg1);
 }
 
-export function UpdateProject(arg1, arg2, arg3) {
-  return window['go']['main']['App']['UpdateProject'](arg1, arg2, arg3);
+export function UpdateTag(arg1) {
+  return window['go']['main']['App']['UpdateTag'](arg1);
 }
 
-export function UpdateTask(arg1, arg2, arg3) {
-  return window['go']['main']['App']['UpdateTask'](arg1, arg2, arg3);
+export function UpdateTask(arg1) {
+  return window['go']['main']['App']['UpdateTask'](arg1);
 }